@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
 )
 
 type CompileConfig struct {
@@ -19,6 +20,7 @@ type CompileConfig struct {
 const (
 	ErrMissingConfig      = 3
 	ErrInvalidConfig      = 4
+	ErrInvalidOptions     = 5
 	ErrInputPathRequired  = 12
 	ErrOutputPathRequired = 13
 	ErrCompileFailed      = 1
@@ -69,10 +71,21 @@ func main() {
 	logInfo(compileConfig.Verbose, "Processing Morphe registry from: '%s'", compileConfig.InputPath)
 	logInfo(compileConfig.Verbose, "Output TypeScript types to: '%s'", compileConfig.OutputPath)
 
-	morpheConfig := compile.DefaultMorpheCompileConfig(
+	pluginOptions, optionsErr := cfg.DecodePluginOptions(compileConfig.Config)
+	if optionsErr != nil {
+		fmt.Fprintln(os.Stderr, "Error: Invalid plugin options:", optionsErr)
+		os.Exit(ErrInvalidOptions)
+	}
+
+	morpheConfig, morpheConfigErr := compile.PluginMorpheCompileConfig(
 		compileConfig.InputPath,
 		compileConfig.OutputPath,
+		pluginOptions,
 	)
+	if morpheConfigErr != nil {
+		fmt.Fprintln(os.Stderr, "Error: Invalid plugin options:", morpheConfigErr)
+		os.Exit(ErrInvalidOptions)
+	}
 
 	logInfo(compileConfig.Verbose, "Starting compilation process...")
 	compileErr := compile.MorpheToTypescript(morpheConfig)
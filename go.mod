@@ -8,6 +8,7 @@ require (
 	github.com/kalo-build/go-util v0.0.0-20250329083327-00e97aeff9b7
 	github.com/kalo-build/morphe-go v0.0.0-20251016080731-9aae9ab2af3e
 	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -15,5 +16,4 @@ require (
 	github.com/gobeam/stringy v0.0.7 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
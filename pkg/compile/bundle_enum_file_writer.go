@@ -0,0 +1,49 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// BundleEnumFileWriter writes enums into a shared TsBundle instead of one file per enum.
+//
+// WriteEnum returns the bundled block of the enum only, the bundle file is written on `Flush`.
+type BundleEnumFileWriter struct {
+	Bundle *TsBundle
+	// DirName is the output directory the enums would have in a directory tree (e.g. "enums"), used to resolve relative imports
+	DirName string
+	// CollisionSuffix is appended to enum names already taken in the bundle (e.g. "Enum")
+	CollisionSuffix string
+}
+
+func (w *BundleEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	if w.Bundle == nil {
+		return nil, ErrNoBundle
+	}
+	enumLines, addErr := w.Bundle.addEnum(w.DirName, w.CollisionSuffix, enumName, enumDefinition)
+	if addErr != nil {
+		return nil, addErr
+	}
+
+	enumContents, enumContentsErr := core.LinesToString(enumLines)
+	if enumContentsErr != nil {
+		return nil, enumContentsErr
+	}
+	return []byte(enumContents), nil
+}
+
+func (w *BundleEnumFileWriter) ClearFile(enumName string) error {
+	if w.Bundle == nil {
+		return ErrNoBundle
+	}
+	w.Bundle.removeFile(w.DirName, enumName)
+	return nil
+}
+
+// Flush writes the bundle file once all enums are written.
+func (w *BundleEnumFileWriter) Flush() error {
+	if w.Bundle == nil {
+		return ErrNoBundle
+	}
+	return w.Bundle.Flush()
+}
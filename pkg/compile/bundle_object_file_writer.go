@@ -0,0 +1,49 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// BundleObjectFileWriter writes objects into a shared TsBundle instead of one file per main object.
+//
+// WriteObject returns the bundled block of the object only, the bundle file is written on `Flush`.
+type BundleObjectFileWriter struct {
+	Bundle *TsBundle
+	// DirName is the output directory the objects would have in a directory tree (e.g. "models"), used to resolve relative imports
+	DirName string
+	// CollisionSuffix is appended to main object names already taken in the bundle (e.g. "Entity")
+	CollisionSuffix string
+}
+
+func (w *BundleObjectFileWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	if w.Bundle == nil {
+		return nil, ErrNoBundle
+	}
+	objectLines, addErr := w.Bundle.addObject(w.DirName, w.CollisionSuffix, mainObjectName, objectDefinition)
+	if addErr != nil {
+		return nil, addErr
+	}
+
+	objectContents, objectContentsErr := core.LinesToString(objectLines)
+	if objectContentsErr != nil {
+		return nil, objectContentsErr
+	}
+	return []byte(objectContents), nil
+}
+
+func (w *BundleObjectFileWriter) ClearFile(mainObjectName string) error {
+	if w.Bundle == nil {
+		return ErrNoBundle
+	}
+	w.Bundle.removeFile(w.DirName, mainObjectName)
+	return nil
+}
+
+// Flush writes the bundle file once all objects are written.
+func (w *BundleObjectFileWriter) Flush() error {
+	if w.Bundle == nil {
+		return ErrNoBundle
+	}
+	return w.Bundle.Flush()
+}
@@ -0,0 +1,87 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNoOutputs = errors.New("at least one output must be enabled")
+var ErrGuardsRequireTypeFiles = errors.New("guards output requires the types output without bundle")
+var ErrWireFormatRequiresTypeFiles = errors.New("wireFormat output requires the types output without bundle")
+var ErrEnumHelpersRequireTypeFiles = errors.New("enumHelpers output requires the types output without bundle")
+
+func ErrUnsupportedFieldOptionality(optionality FieldOptionality) error {
+	return fmt.Errorf("unsupported field optionality '%s'", optionality)
+}
+
+func ErrUnsupportedEnumStyle(style EnumStyle) error {
+	return fmt.Errorf("unsupported enum style '%s'", style)
+}
+
+func ErrUnsupportedEnumEntryOrder(entryOrder EnumEntryOrder) error {
+	return fmt.Errorf("unsupported enum entry order '%s'", entryOrder)
+}
+
+func ErrUnsupportedIdentifierHandling(identifierHandling IdentifierHandling) error {
+	return fmt.Errorf("unsupported identifier handling '%s'", identifierHandling)
+}
+
+func ErrInvalidTypeNamePrefix(prefix string) error {
+	return fmt.Errorf("invalid type name prefix '%s', must be a valid typescript identifier", prefix)
+}
+
+func ErrInvalidTypeNameSuffix(suffix string) error {
+	return fmt.Errorf("invalid type name suffix '%s', must only contain letters, digits, '_' or '$'", suffix)
+}
+
+func ErrInvalidOutputDirName(kind string, dirName string) error {
+	return fmt.Errorf("invalid %s output directory '%s', must be a single directory name", kind, dirName)
+}
+
+func ErrDuplicateOutputDirName(kind string, otherKind string, dirName string) error {
+	return fmt.Errorf("%s output directory '%s' is already used by %s", kind, dirName, otherKind)
+}
+
+func ErrReservedOutputDirName(dirName string) error {
+	return fmt.Errorf("output directory '%s' is reserved for the zod output", dirName)
+}
+
+func ErrUnknownPluginOption(optionPath string) error {
+	return fmt.Errorf("unknown plugin option '%s'", optionPath)
+}
+
+func ErrInvalidPluginOptionType(optionPath string, expectedType string) error {
+	return fmt.Errorf("invalid plugin option '%s', must be %s", optionPath, expectedType)
+}
+
+func ErrInvalidPluginOption(optionPath string, optionErr error) error {
+	return fmt.Errorf("invalid plugin option '%s': %w", optionPath, optionErr)
+}
+
+func ErrInvalidBundleName(bundleName string) error {
+	return fmt.Errorf("invalid bundle name '%s', must be a plain file name", bundleName)
+}
+
+func ErrUnsupportedOutputKind(outputKind OutputKind) error {
+	return fmt.Errorf("unsupported output '%s'", outputKind)
+}
+
+func ErrDuplicateOutputKind(outputKind OutputKind) error {
+	return fmt.Errorf("duplicate output '%s'", outputKind)
+}
+
+func ErrUnsupportedTypeMappingFieldType(fieldType string) error {
+	return fmt.Errorf("unsupported type mapping for '%s', must be a primitive morphe field type", fieldType)
+}
+
+func ErrInvalidTypeMappingTypeName(fieldType string, typeName string) error {
+	return fmt.Errorf("invalid type mapping '%s' for '%s', must be a valid typescript type name", typeName, fieldType)
+}
+
+func ErrTypeMappingRequiresModulePath(fieldType string, typeName string) error {
+	return fmt.Errorf("type mapping '%s' for '%s' is not a builtin type and requires a module path", typeName, fieldType)
+}
+
+func ErrInvalidTypeMappingModulePath(fieldType string, modulePath string) error {
+	return fmt.Errorf("invalid type mapping module path '%s' for '%s', must be a package module path or path alias (e.g. '@acme/types'), relative and absolute paths are not supported", modulePath, fieldType)
+}
@@ -0,0 +1,20 @@
+package cfg
+
+// EnumEntryOrder controls the order enum entries are emitted in.
+type EnumEntryOrder string
+
+const (
+	// EnumEntryOrderSorted emits enum entries sorted by name (default).
+	EnumEntryOrderSorted EnumEntryOrder = ""
+	// EnumEntryOrderDeclared emits enum entries in the order they are declared in the registry YAML.
+	EnumEntryOrderDeclared EnumEntryOrder = "declared"
+)
+
+func (o EnumEntryOrder) Validate() error {
+	switch o {
+	case EnumEntryOrderSorted, EnumEntryOrderDeclared:
+		return nil
+	default:
+		return ErrUnsupportedEnumEntryOrder(o)
+	}
+}
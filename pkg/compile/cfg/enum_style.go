@@ -0,0 +1,29 @@
+package cfg
+
+// EnumStyle controls the form enums are declared in by the type definition writers.
+//
+// All styles declare a type named after the enum, so fields referencing the enum are the same for every style.
+type EnumStyle string
+
+const (
+	// EnumStyleEnum declares enums as `export enum Nationality { ... }` (default).
+	EnumStyleEnum EnumStyle = ""
+	// EnumStyleDeclareEnum declares ambient enums (`export declare enum Nationality { ... }`).
+	EnumStyleDeclareEnum EnumStyle = "declareEnum"
+	// EnumStyleConstEnum declares const enums (`export const enum Nationality { ... }`), which are inlined on use.
+	EnumStyleConstEnum EnumStyle = "constEnum"
+	// EnumStyleUnion declares enums as literal union types (`export type Nationality = 'German' | 'French'`).
+	EnumStyleUnion EnumStyle = "union"
+	// EnumStyleConstObject declares enums as frozen `as const` objects along with a type of their values
+	// (`export type Nationality = (typeof Nationality)[keyof typeof Nationality]`).
+	EnumStyleConstObject EnumStyle = "constObject"
+)
+
+func (s EnumStyle) Validate() error {
+	switch s {
+	case EnumStyleEnum, EnumStyleDeclareEnum, EnumStyleConstEnum, EnumStyleUnion, EnumStyleConstObject:
+		return nil
+	default:
+		return ErrUnsupportedEnumStyle(s)
+	}
+}
@@ -0,0 +1,22 @@
+package cfg
+
+// FieldOptionality controls how fields without the `mandatory` attribute are emitted.
+type FieldOptionality string
+
+const (
+	// FieldOptionalityRequired emits every field as a required property (default).
+	FieldOptionalityRequired FieldOptionality = ""
+	// FieldOptionalityOptional emits non-mandatory fields as optional properties (`field?: T`).
+	FieldOptionalityOptional FieldOptionality = "optional"
+	// FieldOptionalityNullable emits non-mandatory fields as nullable properties (`field: T | null`).
+	FieldOptionalityNullable FieldOptionality = "nullable"
+)
+
+func (o FieldOptionality) Validate() error {
+	switch o {
+	case FieldOptionalityRequired, FieldOptionalityOptional, FieldOptionalityNullable:
+		return nil
+	default:
+		return ErrUnsupportedFieldOptionality(o)
+	}
+}
@@ -0,0 +1,25 @@
+package cfg
+
+// IdentifierHandling controls how field and enum entry names which are not valid TypeScript identifier names are
+// written, e.g. a Morphe field `2FA`.
+//
+// Type and enum names are referenced across files, so invalid type and enum names always fail the compilation.
+type IdentifierHandling string
+
+const (
+	// IdentifierHandlingError fails the compilation on invalid names (default).
+	IdentifierHandlingError IdentifierHandling = ""
+	// IdentifierHandlingQuote writes invalid names as string literals, e.g. `'2FA': string`.
+	IdentifierHandlingQuote IdentifierHandling = "quote"
+	// IdentifierHandlingRename drops invalid characters and prefixes names with `$` if needed, e.g. `$2fa: string`.
+	IdentifierHandlingRename IdentifierHandling = "rename"
+)
+
+func (h IdentifierHandling) Validate() error {
+	switch h {
+	case IdentifierHandlingError, IdentifierHandlingQuote, IdentifierHandlingRename:
+		return nil
+	default:
+		return ErrUnsupportedIdentifierHandling(h)
+	}
+}
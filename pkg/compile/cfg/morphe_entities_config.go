@@ -1,8 +1,35 @@
 package cfg
 
 type MorpheEntitiesConfig struct {
+	FieldOptionality FieldOptionality
+	TypeNaming       TypeNaming
+	TypeMappings     TypeMappings
+	// IdentifierHandling controls how field names which are not valid identifier names are written, defaults to failing
+	IdentifierHandling IdentifierHandling
+	// DiscriminatedUnions emits a `CommentCommentable` discriminated union type per polymorphic for-relation
+	DiscriminatedUnions bool
+	// SourceFileNames are the registry file names of each entity by name, documented on the compiled types.
+	//
+	// Loaded from the registry entities directory if nil, since the registry does not track file paths.
+	SourceFileNames map[string]string
 }
 
 func (config MorpheEntitiesConfig) Validate() error {
+	optionalityErr := config.FieldOptionality.Validate()
+	if optionalityErr != nil {
+		return optionalityErr
+	}
+	typeNamingErr := config.TypeNaming.Validate()
+	if typeNamingErr != nil {
+		return typeNamingErr
+	}
+	typeMappingsErr := config.TypeMappings.Validate()
+	if typeMappingsErr != nil {
+		return typeMappingsErr
+	}
+	identifierHandlingErr := config.IdentifierHandling.Validate()
+	if identifierHandlingErr != nil {
+		return identifierHandlingErr
+	}
 	return nil
 }
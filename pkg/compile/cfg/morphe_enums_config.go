@@ -1,8 +1,30 @@
 package cfg
 
 type MorpheEnumsConfig struct {
+	// Style is the form enums are declared in, defaults to `export enum`
+	Style EnumStyle
+	// EntryOrder is the order enum entries are emitted in, defaults to sorted by name
+	EntryOrder EnumEntryOrder
+	// IdentifierHandling controls how entry names which are not valid identifier names are written, defaults to failing
+	IdentifierHandling IdentifierHandling
+	// DeclaredEntryNames are the entry names of each enum in declaration order, used by `EnumEntryOrderDeclared`.
+	//
+	// Loaded from the registry enums directory if nil, since the loaded enums hold their entries in a map.
+	DeclaredEntryNames map[string][]string
+	// SourceFileNames are the registry file names of each enum by name, documented on the compiled types.
+	//
+	// Loaded from the registry enums directory if nil, since the registry does not track file paths.
+	SourceFileNames map[string]string
 }
 
 func (config MorpheEnumsConfig) Validate() error {
-	return nil
+	styleErr := config.Style.Validate()
+	if styleErr != nil {
+		return styleErr
+	}
+	entryOrderErr := config.EntryOrder.Validate()
+	if entryOrderErr != nil {
+		return entryOrderErr
+	}
+	return config.IdentifierHandling.Validate()
 }
@@ -1,8 +1,39 @@
 package cfg
 
 type MorpheModelsConfig struct {
+	FieldOptionality FieldOptionality
+	TypeNaming       TypeNaming
+	TypeMappings     TypeMappings
+	// IdentifierHandling controls how field names which are not valid identifier names are written, defaults to failing
+	IdentifierHandling IdentifierHandling
+	// BrandedIDs emits a branded `PersonID` type per model, used for its primary identifier field and all foreign keys
+	BrandedIDs bool
+	// InputTypes emits `PersonCreate` and `PersonUpdate` input types derived from each model
+	InputTypes bool
+	// DiscriminatedUnions emits a `CommentCommentable` discriminated union type per polymorphic for-relation
+	DiscriminatedUnions bool
+	// SourceFileNames are the registry file names of each model by name, documented on the compiled types.
+	//
+	// Loaded from the registry models directory if nil, since the registry does not track file paths.
+	SourceFileNames map[string]string
 }
 
 func (config MorpheModelsConfig) Validate() error {
+	optionalityErr := config.FieldOptionality.Validate()
+	if optionalityErr != nil {
+		return optionalityErr
+	}
+	typeNamingErr := config.TypeNaming.Validate()
+	if typeNamingErr != nil {
+		return typeNamingErr
+	}
+	typeMappingsErr := config.TypeMappings.Validate()
+	if typeMappingsErr != nil {
+		return typeMappingsErr
+	}
+	identifierHandlingErr := config.IdentifierHandling.Validate()
+	if identifierHandlingErr != nil {
+		return identifierHandlingErr
+	}
 	return nil
 }
@@ -1,8 +1,23 @@
 package cfg
 
 type MorpheStructuresConfig struct {
+	TypeMappings TypeMappings
+	// IdentifierHandling controls how field names which are not valid identifier names are written, defaults to failing
+	IdentifierHandling IdentifierHandling
+	// SourceFileNames are the registry file names of each structure by name, documented on the compiled types.
+	//
+	// Loaded from the registry structures directory if nil, since the registry does not track file paths.
+	SourceFileNames map[string]string
 }
 
 func (config MorpheStructuresConfig) Validate() error {
+	typeMappingsErr := config.TypeMappings.Validate()
+	if typeMappingsErr != nil {
+		return typeMappingsErr
+	}
+	identifierHandlingErr := config.IdentifierHandling.Validate()
+	if identifierHandlingErr != nil {
+		return identifierHandlingErr
+	}
 	return nil
 }
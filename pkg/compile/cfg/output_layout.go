@@ -0,0 +1,69 @@
+package cfg
+
+import "strings"
+
+const (
+	DefaultEnumsDirName      = "enums"
+	DefaultModelsDirName     = "models"
+	DefaultStructuresDirName = "structures"
+	DefaultEntitiesDirName   = "entities"
+)
+
+// OutputLayout names the output directories of each Morphe kind relative to the base output directory.
+//
+// Empty names fall back to the defaults (`enums`, `models`, `structures`, `entities`).
+type OutputLayout struct {
+	EnumsDirName      string
+	ModelsDirName     string
+	StructuresDirName string
+	EntitiesDirName   string
+}
+
+func (l OutputLayout) GetEnumsDirName() string {
+	return getDirNameOrDefault(l.EnumsDirName, DefaultEnumsDirName)
+}
+
+func (l OutputLayout) GetModelsDirName() string {
+	return getDirNameOrDefault(l.ModelsDirName, DefaultModelsDirName)
+}
+
+func (l OutputLayout) GetStructuresDirName() string {
+	return getDirNameOrDefault(l.StructuresDirName, DefaultStructuresDirName)
+}
+
+func (l OutputLayout) GetEntitiesDirName() string {
+	return getDirNameOrDefault(l.EntitiesDirName, DefaultEntitiesDirName)
+}
+
+func (l OutputLayout) Validate() error {
+	allDirNames := map[string]string{
+		"enums":      l.GetEnumsDirName(),
+		"models":     l.GetModelsDirName(),
+		"structures": l.GetStructuresDirName(),
+		"entities":   l.GetEntitiesDirName(),
+	}
+	usedDirNames := map[string]string{}
+	for _, kind := range []string{"enums", "models", "structures", "entities"} {
+		dirName := allDirNames[kind]
+		if !isValidDirName(dirName) {
+			return ErrInvalidOutputDirName(kind, dirName)
+		}
+		if otherKind, dirNameUsed := usedDirNames[dirName]; dirNameUsed {
+			return ErrDuplicateOutputDirName(kind, otherKind, dirName)
+		}
+		usedDirNames[dirName] = kind
+	}
+	return nil
+}
+
+// isValidDirName only accepts single path segments, since the output directories are linked as siblings (`../enums/...`)
+func isValidDirName(dirName string) bool {
+	return dirName != "." && dirName != ".." && !strings.ContainsAny(dirName, `/\\:`)
+}
+
+func getDirNameOrDefault(dirName string, defaultDirName string) string {
+	if dirName == "" {
+		return defaultDirName
+	}
+	return dirName
+}
@@ -0,0 +1,134 @@
+package cfg
+
+import "regexp"
+
+var bundleNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`)
+
+// ZodOutputDirName is the directory under the base output directory holding the `zod` output tree.
+const ZodOutputDirName = "zod"
+
+// OutputKind names one of the files generated per enum and object.
+type OutputKind string
+
+const (
+	// OutputKindTypes emits `.d.ts` type definitions.
+	OutputKindTypes OutputKind = "types"
+	// OutputKindZod emits Zod schemas as `.ts` files under a separate `zod` directory tree.
+	OutputKindZod OutputKind = "zod"
+	// OutputKindJsonSchema emits `.schema.json` documents next to the type definitions.
+	OutputKindJsonSchema OutputKind = "jsonSchema"
+	// OutputKindGuards emits `.guard.ts` runtime type guards next to the type definitions.
+	OutputKindGuards OutputKind = "guards"
+	// OutputKindWireFormat emits `.wire.ts` JSON wire format types and converters next to the type definitions.
+	OutputKindWireFormat OutputKind = "wireFormat"
+	// OutputKindEnumHelpers emits `.helpers.ts` enum value arrays, lookups and guards next to the enum type definitions.
+	OutputKindEnumHelpers OutputKind = "enumHelpers"
+)
+
+// PluginOptions are the options passed to the plugin through the `config` object of the CLI.
+type PluginOptions struct {
+	Layout OutputLayout
+	// Bundle is the file name (without suffix) of a single-file bundle replacing the `types` directory tree, if set
+	Bundle string
+	// Index enables the barrel `index.ts` files of the `types` directory tree
+	Index   bool
+	Outputs []OutputKind
+	// TypeMappings override the typescript types of primitive field types for models, structures and entities
+	TypeMappings TypeMappings
+	// IdentifierHandling controls how field and enum entry names which are not valid identifier names are written
+	IdentifierHandling IdentifierHandling
+
+	Enums    MorpheEnumsConfig
+	Models   MorpheModelsConfig
+	Entities MorpheEntitiesConfig
+}
+
+func DefaultPluginOptions() PluginOptions {
+	return PluginOptions{
+		Index: true,
+		Outputs: []OutputKind{
+			OutputKindTypes,
+		},
+	}
+}
+
+func (options PluginOptions) HasOutput(outputKind OutputKind) bool {
+	for _, enabledOutputKind := range options.Outputs {
+		if enabledOutputKind == outputKind {
+			return true
+		}
+	}
+	return false
+}
+
+func (options PluginOptions) Validate() error {
+	if layoutErr := options.Layout.Validate(); layoutErr != nil {
+		return ErrInvalidPluginOption(pluginOptionLayout, layoutErr)
+	}
+	if options.Bundle != "" && !bundleNamePattern.MatchString(options.Bundle) {
+		return ErrInvalidPluginOption(pluginOptionBundle, ErrInvalidBundleName(options.Bundle))
+	}
+	if outputsErr := options.validateOutputs(); outputsErr != nil {
+		return ErrInvalidPluginOption(pluginOptionOutputs, outputsErr)
+	}
+	if typeMappingsErr := options.TypeMappings.Validate(); typeMappingsErr != nil {
+		return ErrInvalidPluginOption(pluginOptionTypeMappings, typeMappingsErr)
+	}
+	if identifierHandlingErr := options.IdentifierHandling.Validate(); identifierHandlingErr != nil {
+		return ErrInvalidPluginOption(pluginOptionIdentifierHandling, identifierHandlingErr)
+	}
+	if styleErr := options.Enums.Style.Validate(); styleErr != nil {
+		return ErrInvalidPluginOption(pluginOptionEnums+"."+pluginOptionStyle, styleErr)
+	}
+	if entryOrderErr := options.Enums.EntryOrder.Validate(); entryOrderErr != nil {
+		return ErrInvalidPluginOption(pluginOptionEnums+"."+pluginOptionEntryOrder, entryOrderErr)
+	}
+	if optionalityErr := options.Models.FieldOptionality.Validate(); optionalityErr != nil {
+		return ErrInvalidPluginOption(pluginOptionModels+"."+pluginOptionOptionality, optionalityErr)
+	}
+	if typeNamingErr := options.Models.TypeNaming.Validate(); typeNamingErr != nil {
+		return ErrInvalidPluginOption(pluginOptionModels, typeNamingErr)
+	}
+	if optionalityErr := options.Entities.FieldOptionality.Validate(); optionalityErr != nil {
+		return ErrInvalidPluginOption(pluginOptionEntities+"."+pluginOptionOptionality, optionalityErr)
+	}
+	if typeNamingErr := options.Entities.TypeNaming.Validate(); typeNamingErr != nil {
+		return ErrInvalidPluginOption(pluginOptionEntities, typeNamingErr)
+	}
+	return nil
+}
+
+func (options PluginOptions) validateOutputs() error {
+	if len(options.Outputs) == 0 {
+		return ErrNoOutputs
+	}
+	enabledOutputKinds := map[OutputKind]bool{}
+	for _, outputKind := range options.Outputs {
+		switch outputKind {
+		case OutputKindTypes, OutputKindZod, OutputKindJsonSchema, OutputKindGuards, OutputKindWireFormat, OutputKindEnumHelpers:
+		default:
+			return ErrUnsupportedOutputKind(outputKind)
+		}
+		if enabledOutputKinds[outputKind] {
+			return ErrDuplicateOutputKind(outputKind)
+		}
+		enabledOutputKinds[outputKind] = true
+	}
+	if enabledOutputKinds[OutputKindGuards] && (!enabledOutputKinds[OutputKindTypes] || options.Bundle != "") {
+		return ErrGuardsRequireTypeFiles
+	}
+	if enabledOutputKinds[OutputKindWireFormat] && (!enabledOutputKinds[OutputKindTypes] || options.Bundle != "") {
+		return ErrWireFormatRequiresTypeFiles
+	}
+	if enabledOutputKinds[OutputKindEnumHelpers] && (!enabledOutputKinds[OutputKindTypes] || options.Bundle != "") {
+		return ErrEnumHelpersRequireTypeFiles
+	}
+	if enabledOutputKinds[OutputKindZod] {
+		for _, dirName := range []string{options.Layout.GetEnumsDirName(), options.Layout.GetModelsDirName(), options.Layout.GetStructuresDirName(), options.Layout.GetEntitiesDirName()} {
+			if dirName == ZodOutputDirName {
+				return ErrReservedOutputDirName(dirName)
+			}
+		}
+	}
+	return nil
+}
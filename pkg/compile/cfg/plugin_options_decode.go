@@ -0,0 +1,280 @@
+package cfg
+
+import "github.com/kalo-build/go-util/core"
+
+const (
+	pluginOptionLayout              = "layout"
+	pluginOptionBundle              = "bundle"
+	pluginOptionIndex               = "index"
+	pluginOptionOutputs             = "outputs"
+	pluginOptionTypeMappings        = "typeMappings"
+	pluginOptionTypeName            = "type"
+	pluginOptionModulePath          = "module"
+	pluginOptionIdentifierHandling  = "identifierHandling"
+	pluginOptionEnums               = "enums"
+	pluginOptionStyle               = "style"
+	pluginOptionEntryOrder          = "entryOrder"
+	pluginOptionModels              = "models"
+	pluginOptionEntities            = "entities"
+	pluginOptionOptionality         = "optionality"
+	pluginOptionTypePrefix          = "typePrefix"
+	pluginOptionTypeSuffix          = "typeSuffix"
+	pluginOptionBrandedIDs          = "brandedIDs"
+	pluginOptionInputTypes          = "inputTypes"
+	pluginOptionDiscriminatedUnions = "discriminatedUnions"
+)
+
+// DecodePluginOptions decodes the raw `config` object of the CLI on top of the default plugin options.
+//
+// Unknown keys and values of the wrong JSON type are rejected with the full path of the offending option.
+func DecodePluginOptions(rawOptions map[string]any) (PluginOptions, error) {
+	options := DefaultPluginOptions()
+	for _, optionName := range core.MapKeysSorted(rawOptions) {
+		rawValue := rawOptions[optionName]
+
+		var decodeErr error
+		switch optionName {
+		case pluginOptionLayout:
+			options.Layout, decodeErr = decodeOutputLayout(optionName, rawValue)
+		case pluginOptionBundle:
+			options.Bundle, decodeErr = decodeStringOption(optionName, rawValue)
+		case pluginOptionIndex:
+			options.Index, decodeErr = decodeBoolOption(optionName, rawValue)
+		case pluginOptionOutputs:
+			options.Outputs, decodeErr = decodeOutputKinds(optionName, rawValue)
+		case pluginOptionTypeMappings:
+			options.TypeMappings, decodeErr = decodeTypeMappings(optionName, rawValue)
+		case pluginOptionIdentifierHandling:
+			var rawIdentifierHandling string
+			rawIdentifierHandling, decodeErr = decodeStringOption(optionName, rawValue)
+			options.IdentifierHandling = IdentifierHandling(rawIdentifierHandling)
+		case pluginOptionEnums:
+			options.Enums, decodeErr = decodeEnumsOptions(optionName, rawValue)
+		case pluginOptionModels:
+			options.Models, decodeErr = decodeModelsOptions(optionName, rawValue)
+		case pluginOptionEntities:
+			options.Entities, decodeErr = decodeEntitiesOptions(optionName, rawValue)
+		default:
+			decodeErr = ErrUnknownPluginOption(optionName)
+		}
+		if decodeErr != nil {
+			return PluginOptions{}, decodeErr
+		}
+	}
+	return options, nil
+}
+
+func decodeOutputLayout(optionPath string, rawValue any) (OutputLayout, error) {
+	rawLayout, objectErr := decodeObjectOption(optionPath, rawValue)
+	if objectErr != nil {
+		return OutputLayout{}, objectErr
+	}
+
+	layout := OutputLayout{}
+	for _, optionName := range core.MapKeysSorted(rawLayout) {
+		subOptionPath := optionPath + "." + optionName
+
+		var decodeErr error
+		switch optionName {
+		case DefaultEnumsDirName:
+			layout.EnumsDirName, decodeErr = decodeStringOption(subOptionPath, rawLayout[optionName])
+		case DefaultModelsDirName:
+			layout.ModelsDirName, decodeErr = decodeStringOption(subOptionPath, rawLayout[optionName])
+		case DefaultStructuresDirName:
+			layout.StructuresDirName, decodeErr = decodeStringOption(subOptionPath, rawLayout[optionName])
+		case DefaultEntitiesDirName:
+			layout.EntitiesDirName, decodeErr = decodeStringOption(subOptionPath, rawLayout[optionName])
+		default:
+			decodeErr = ErrUnknownPluginOption(subOptionPath)
+		}
+		if decodeErr != nil {
+			return OutputLayout{}, decodeErr
+		}
+	}
+	return layout, nil
+}
+
+func decodeEnumsOptions(optionPath string, rawValue any) (MorpheEnumsConfig, error) {
+	rawEnumsOptions, objectErr := decodeObjectOption(optionPath, rawValue)
+	if objectErr != nil {
+		return MorpheEnumsConfig{}, objectErr
+	}
+
+	enumsConfig := MorpheEnumsConfig{}
+	for _, optionName := range core.MapKeysSorted(rawEnumsOptions) {
+		subOptionPath := optionPath + "." + optionName
+
+		var decodeErr error
+		switch optionName {
+		case pluginOptionStyle:
+			var rawStyle string
+			rawStyle, decodeErr = decodeStringOption(subOptionPath, rawEnumsOptions[optionName])
+			enumsConfig.Style = EnumStyle(rawStyle)
+		case pluginOptionEntryOrder:
+			var rawEntryOrder string
+			rawEntryOrder, decodeErr = decodeStringOption(subOptionPath, rawEnumsOptions[optionName])
+			enumsConfig.EntryOrder = EnumEntryOrder(rawEntryOrder)
+		default:
+			decodeErr = ErrUnknownPluginOption(subOptionPath)
+		}
+		if decodeErr != nil {
+			return MorpheEnumsConfig{}, decodeErr
+		}
+	}
+	return enumsConfig, nil
+}
+
+func decodeModelsOptions(optionPath string, rawValue any) (MorpheModelsConfig, error) {
+	rawModelsOptions, objectErr := decodeObjectOption(optionPath, rawValue)
+	if objectErr != nil {
+		return MorpheModelsConfig{}, objectErr
+	}
+
+	modelsConfig := MorpheModelsConfig{}
+	for _, optionName := range core.MapKeysSorted(rawModelsOptions) {
+		subOptionPath := optionPath + "." + optionName
+
+		var decodeErr error
+		switch optionName {
+		case pluginOptionBrandedIDs:
+			modelsConfig.BrandedIDs, decodeErr = decodeBoolOption(subOptionPath, rawModelsOptions[optionName])
+		case pluginOptionInputTypes:
+			modelsConfig.InputTypes, decodeErr = decodeBoolOption(subOptionPath, rawModelsOptions[optionName])
+		case pluginOptionDiscriminatedUnions:
+			modelsConfig.DiscriminatedUnions, decodeErr = decodeBoolOption(subOptionPath, rawModelsOptions[optionName])
+		default:
+			decodeErr = decodeObjectTypeOption(subOptionPath, optionName, rawModelsOptions[optionName], &modelsConfig.FieldOptionality, &modelsConfig.TypeNaming)
+		}
+		if decodeErr != nil {
+			return MorpheModelsConfig{}, decodeErr
+		}
+	}
+	return modelsConfig, nil
+}
+
+func decodeEntitiesOptions(optionPath string, rawValue any) (MorpheEntitiesConfig, error) {
+	rawEntitiesOptions, objectErr := decodeObjectOption(optionPath, rawValue)
+	if objectErr != nil {
+		return MorpheEntitiesConfig{}, objectErr
+	}
+
+	entitiesConfig := MorpheEntitiesConfig{}
+	for _, optionName := range core.MapKeysSorted(rawEntitiesOptions) {
+		subOptionPath := optionPath + "." + optionName
+
+		var decodeErr error
+		switch optionName {
+		case pluginOptionDiscriminatedUnions:
+			entitiesConfig.DiscriminatedUnions, decodeErr = decodeBoolOption(subOptionPath, rawEntitiesOptions[optionName])
+		default:
+			decodeErr = decodeObjectTypeOption(subOptionPath, optionName, rawEntitiesOptions[optionName], &entitiesConfig.FieldOptionality, &entitiesConfig.TypeNaming)
+		}
+		if decodeErr != nil {
+			return MorpheEntitiesConfig{}, decodeErr
+		}
+	}
+	return entitiesConfig, nil
+}
+
+// decodeObjectTypeOption decodes the options shared by the models and entities options
+func decodeObjectTypeOption(optionPath string, optionName string, rawValue any, optionality *FieldOptionality, typeNaming *TypeNaming) error {
+	var decodeErr error
+	switch optionName {
+	case pluginOptionOptionality:
+		var rawOptionality string
+		rawOptionality, decodeErr = decodeStringOption(optionPath, rawValue)
+		*optionality = FieldOptionality(rawOptionality)
+	case pluginOptionTypePrefix:
+		typeNaming.Prefix, decodeErr = decodeStringOption(optionPath, rawValue)
+	case pluginOptionTypeSuffix:
+		typeNaming.Suffix, decodeErr = decodeStringOption(optionPath, rawValue)
+	default:
+		decodeErr = ErrUnknownPluginOption(optionPath)
+	}
+	return decodeErr
+}
+
+// decodeTypeMappings accepts either a type name (`"Time": "string"`) or an imported type
+// (`"UUID": {"type": "Uuid", "module": "@acme/types"}`) per field type.
+func decodeTypeMappings(optionPath string, rawValue any) (TypeMappings, error) {
+	rawTypeMappings, objectErr := decodeObjectOption(optionPath, rawValue)
+	if objectErr != nil {
+		return nil, objectErr
+	}
+
+	typeMappings := TypeMappings{}
+	for _, fieldType := range core.MapKeysSorted(rawTypeMappings) {
+		subOptionPath := optionPath + "." + fieldType
+
+		rawTypeMapping := rawTypeMappings[fieldType]
+		if typeName, isString := rawTypeMapping.(string); isString {
+			typeMappings[fieldType] = TypeMapping{TypeName: typeName}
+			continue
+		}
+
+		rawImportedType, isObject := rawTypeMapping.(map[string]any)
+		if !isObject {
+			return nil, ErrInvalidPluginOptionType(subOptionPath, "a string or an object")
+		}
+		typeMapping := TypeMapping{}
+		for _, optionName := range core.MapKeysSorted(rawImportedType) {
+			importOptionPath := subOptionPath + "." + optionName
+
+			var decodeErr error
+			switch optionName {
+			case pluginOptionTypeName:
+				typeMapping.TypeName, decodeErr = decodeStringOption(importOptionPath, rawImportedType[optionName])
+			case pluginOptionModulePath:
+				typeMapping.ModulePath, decodeErr = decodeStringOption(importOptionPath, rawImportedType[optionName])
+			default:
+				decodeErr = ErrUnknownPluginOption(importOptionPath)
+			}
+			if decodeErr != nil {
+				return nil, decodeErr
+			}
+		}
+		typeMappings[fieldType] = typeMapping
+	}
+	return typeMappings, nil
+}
+
+func decodeOutputKinds(optionPath string, rawValue any) ([]OutputKind, error) {
+	rawOutputKinds, isList := rawValue.([]any)
+	if !isList {
+		return nil, ErrInvalidPluginOptionType(optionPath, "a list of strings")
+	}
+
+	allOutputKinds := []OutputKind{}
+	for _, rawOutputKind := range rawOutputKinds {
+		outputKind, isString := rawOutputKind.(string)
+		if !isString {
+			return nil, ErrInvalidPluginOptionType(optionPath, "a list of strings")
+		}
+		allOutputKinds = append(allOutputKinds, OutputKind(outputKind))
+	}
+	return allOutputKinds, nil
+}
+
+func decodeObjectOption(optionPath string, rawValue any) (map[string]any, error) {
+	value, isObject := rawValue.(map[string]any)
+	if !isObject {
+		return nil, ErrInvalidPluginOptionType(optionPath, "an object")
+	}
+	return value, nil
+}
+
+func decodeStringOption(optionPath string, rawValue any) (string, error) {
+	value, isString := rawValue.(string)
+	if !isString {
+		return "", ErrInvalidPluginOptionType(optionPath, "a string")
+	}
+	return value, nil
+}
+
+func decodeBoolOption(optionPath string, rawValue any) (bool, error) {
+	value, isBool := rawValue.(bool)
+	if !isBool {
+		return false, ErrInvalidPluginOptionType(optionPath, "a boolean")
+	}
+	return value, nil
+}
@@ -0,0 +1,68 @@
+package cfg
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+)
+
+var typeMappingNamePattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// builtinTypeMappingNames are the typescript types a Morphe field type can be mapped to without an import.
+var builtinTypeMappingNames = map[string]bool{
+	"string":    true,
+	"number":    true,
+	"boolean":   true,
+	"bigint":    true,
+	"null":      true,
+	"undefined": true,
+	"never":     true,
+	"unknown":   true,
+	"any":       true,
+	"Date":      true,
+}
+
+// TypeMapping overrides the typescript type a primitive Morphe field type compiles to, e.g. `Time` -> `string`.
+type TypeMapping struct {
+	// TypeName is either a builtin type (`string`, `never`, `Date`, ...) or a type exported by ModulePath
+	TypeName string
+	// ModulePath is the package the type is imported from (e.g. `@acme/types`), required for non-builtin types.
+	//
+	// Relative and absolute paths are rejected, since they are not rewritten for the depth of each output directory
+	// (e.g. `models/` or `zod/models/`). Local types can be imported through a path alias in the tsconfig `paths`.
+	ModulePath string
+}
+
+func (m TypeMapping) IsBuiltin() bool {
+	return m.ModulePath == "" && builtinTypeMappingNames[m.TypeName]
+}
+
+// TypeMappings maps primitive Morphe field types (`UUID`, `Time`, ...) to the typescript types overriding the defaults.
+type TypeMappings map[string]TypeMapping
+
+func (m TypeMappings) Validate() error {
+	for _, fieldType := range core.MapKeysSorted(m) {
+		typeMapping := m[fieldType]
+		if !yaml.IsModelFieldTypePrimitive(yaml.ModelFieldType(fieldType)) {
+			return ErrUnsupportedTypeMappingFieldType(fieldType)
+		}
+		if !typeMappingNamePattern.MatchString(typeMapping.TypeName) {
+			return ErrInvalidTypeMappingTypeName(fieldType, typeMapping.TypeName)
+		}
+		if typeMapping.ModulePath == "" {
+			if !typeMapping.IsBuiltin() {
+				return ErrTypeMappingRequiresModulePath(fieldType, typeMapping.TypeName)
+			}
+			continue
+		}
+		if strings.HasPrefix(typeMapping.ModulePath, ".") || strings.HasPrefix(typeMapping.ModulePath, "/") {
+			return ErrInvalidTypeMappingModulePath(fieldType, typeMapping.ModulePath)
+		}
+		if builtinTypeMappingNames[typeMapping.TypeName] {
+			return ErrInvalidTypeMappingTypeName(fieldType, typeMapping.TypeName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package cfg
+
+import "regexp"
+
+var typeNamePrefixPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+var typeNameSuffixPattern = regexp.MustCompile(`^[A-Za-z0-9_$]*$`)
+
+// TypeNaming decorates the generated type names of a Morphe kind, e.g. `Person` -> `PersonModel`.
+//
+// The decorated name is used for the main type, its identifier types (`PersonModelIDPrimary`), its file name and
+// all references from other files.
+type TypeNaming struct {
+	Prefix string
+	Suffix string
+}
+
+func (n TypeNaming) GetTypeName(name string) string {
+	return n.Prefix + name + n.Suffix
+}
+
+func (n TypeNaming) Validate() error {
+	if n.Prefix != "" && !typeNamePrefixPattern.MatchString(n.Prefix) {
+		return ErrInvalidTypeNamePrefix(n.Prefix)
+	}
+	if !typeNameSuffixPattern.MatchString(n.Suffix) {
+		return ErrInvalidTypeNameSuffix(n.Suffix)
+	}
+	return nil
+}
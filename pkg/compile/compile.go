@@ -1,13 +1,26 @@
 package compile
 
-import "github.com/kalo-build/morphe-go/pkg/registry"
+import (
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+)
 
 func MorpheToTypescript(config MorpheCompileConfig) error {
+	layoutErr := validateOutputLayoutWriters(config)
+	if layoutErr != nil {
+		return layoutErr
+	}
+
 	r, rErr := registry.LoadMorpheRegistry(config.RegistryHooks, config.MorpheLoadRegistryConfig)
 	if rErr != nil {
 		return rErr
 	}
 
+	var allWrittenEnums CompiledEnums
+	var allWrittenModels CompiledModelObjects
+	var allWrittenStructures CompiledStructureObjects
+	var allWrittenEntities CompiledEntityObjects
+
 	hasEnums := r.HasEnums()
 	if hasEnums {
 		allEnumDefs, compileAllEnumsErr := AllMorpheEnumsToTsEnums(config, r)
@@ -15,7 +28,8 @@ func MorpheToTypescript(config MorpheCompileConfig) error {
 			return compileAllEnumsErr
 		}
 
-		_, writeAllEnumsErr := WriteAllEnumDefinitions(config, allEnumDefs)
+		var writeAllEnumsErr error
+		allWrittenEnums, writeAllEnumsErr = WriteAllEnumDefinitions(config, allEnumDefs)
 		if writeAllEnumsErr != nil {
 			return writeAllEnumsErr
 		}
@@ -28,7 +42,8 @@ func MorpheToTypescript(config MorpheCompileConfig) error {
 			return compileAllModelsErr
 		}
 
-		_, writeAllModelsErr := WriteAllModelObjectDefinitions(config, allModelObjectDefs)
+		var writeAllModelsErr error
+		allWrittenModels, writeAllModelsErr = WriteAllModelObjectDefinitions(config, allModelObjectDefs)
 		if writeAllModelsErr != nil {
 			return writeAllModelsErr
 		}
@@ -41,7 +56,8 @@ func MorpheToTypescript(config MorpheCompileConfig) error {
 			return compileAllStructuresErr
 		}
 
-		_, writeAllStructuresErr := WriteAllStructureObjectDefinitions(config, allStructureObjectDefs)
+		var writeAllStructuresErr error
+		allWrittenStructures, writeAllStructuresErr = WriteAllStructureObjectDefinitions(config, allStructureObjectDefs)
 		if writeAllStructuresErr != nil {
 			return writeAllStructuresErr
 		}
@@ -54,11 +70,45 @@ func MorpheToTypescript(config MorpheCompileConfig) error {
 			return compileAllEntitiesErr
 		}
 
-		_, writeAllEntitiesErr := WriteAllEntityObjectDefinitions(config, allEntityObjectDefs)
+		var writeAllEntitiesErr error
+		allWrittenEntities, writeAllEntitiesErr = WriteAllEntityObjectDefinitions(config, allEntityObjectDefs)
 		if writeAllEntitiesErr != nil {
 			return writeAllEntitiesErr
 		}
 	}
 
+	flushErr := flushAllWriters(config)
+	if flushErr != nil {
+		return flushErr
+	}
+
+	if config.IndexWriter != nil && hasTsDefinitionFileWriters(config) {
+		_, writeAllIndexesErr := WriteAllIndexDefinitions(config, allWrittenEnums, allWrittenModels, allWrittenStructures, allWrittenEntities)
+		if writeAllIndexesErr != nil {
+			return writeAllIndexesErr
+		}
+	}
+
+	return nil
+}
+
+// flushAllWriters writes the files of all writers accumulating their definitions (e.g. a bundle) once everything is
+// written.
+func flushAllWriters(config MorpheCompileConfig) error {
+	allWriters := []any{
+		config.EnumWriter,
+		config.ModelWriter,
+		config.StructureWriter,
+		config.EntityWriter,
+	}
+	for _, writer := range allWriters {
+		flusher, isFlusher := writer.(write.TsFlusher)
+		if !isFlusher {
+			continue
+		}
+		if flushErr := flusher.Flush(); flushErr != nil {
+			return flushErr
+		}
+	}
 	return nil
 }
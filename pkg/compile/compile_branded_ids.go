@@ -0,0 +1,83 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// getModelBrandedIDFieldName returns the primary identifier field of a model which can be branded, if any.
+//
+// Only single field primary identifiers of a primitive field type are branded.
+func getModelBrandedIDFieldName(model yaml.Model) string {
+	primaryIDFieldName, primaryIDErr := yamlops.GetModelPrimaryIdentifierFieldName(model)
+	if primaryIDErr != nil {
+		return ""
+	}
+	primaryIDFieldDef, hasField := model.Fields[primaryIDFieldName]
+	if !hasField || !yaml.IsModelFieldTypePrimitive(primaryIDFieldDef.Type) {
+		return ""
+	}
+	return primaryIDFieldName
+}
+
+func getModelBrandedIDTypeName(modelTypeName string) string {
+	return modelTypeName + "ID"
+}
+
+// getModelBrandedIDType references the branded ID type of a model type from the given module path, or from the same
+// file if empty.
+func getModelBrandedIDType(modulePath string, modelTypeName string) tsdef.TsTypeObject {
+	return tsdef.TsTypeObject{
+		ModulePath: modulePath,
+		Name:       getModelBrandedIDTypeName(modelTypeName),
+	}
+}
+
+// getModelBrandedIDObjectType returns the `PersonID` type alias of a model, or nil if branding is disabled or the
+// model has no brandable primary identifier.
+func getModelBrandedIDObjectType(config morpheModelCompileConfig, model yaml.Model) (*tsdef.Object, error) {
+	if !config.BrandedIDs {
+		return nil, nil
+	}
+	primaryIDFieldName := getModelBrandedIDFieldName(model)
+	if primaryIDFieldName == "" {
+		return nil, nil
+	}
+
+	primaryIDType, typeErr := getTsTypeForMorpheModelFieldType(config.TypeMappings, model.Fields[primaryIDFieldName].Type)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	brandedIDTypeName := getModelBrandedIDTypeName(config.TypeNaming.GetTypeName(model.Name))
+	brandedIDType := tsdef.Object{
+		Name: brandedIDTypeName,
+		Alias: tsdef.TsTypeBranded{
+			ValueType: primaryIDType,
+			Brand:     brandedIDTypeName,
+		},
+	}
+	return &brandedIDType, nil
+}
+
+// getRelatedModelBrandedIDType references the branded ID type of a related model from a sibling model file.
+func getRelatedModelBrandedIDType(config morpheModelCompileConfig, relatedModelDef yaml.Model) (tsdef.TsType, bool) {
+	if !config.BrandedIDs || getModelBrandedIDFieldName(relatedModelDef) == "" {
+		return nil, false
+	}
+	relatedTypeName := config.TypeNaming.GetTypeName(relatedModelDef.Name)
+	return getModelBrandedIDType("./"+strcase.ToKebabCaseLower(relatedTypeName), relatedTypeName), true
+}
+
+// getEntityFieldBrandedIDType references the branded ID type of the model an entity field resolves to, if the field
+// is the model's primary identifier.
+func getEntityFieldBrandedIDType(config morpheEntityCompileConfig, model yaml.Model, fieldName string) (tsdef.TsType, bool) {
+	if !config.ModelsConfig.BrandedIDs || getModelBrandedIDFieldName(model) != fieldName {
+		return nil, false
+	}
+	modelTypeName := config.ModelsConfig.TypeNaming.GetTypeName(model.Name)
+	modulePath := "../" + config.OutputLayout.GetModelsDirName() + "/" + strcase.ToKebabCaseLower(modelTypeName)
+	return getModelBrandedIDType(modulePath, modelTypeName), true
+}
@@ -0,0 +1,119 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/yamlops"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// getMorpheSourceDocs documents the Morphe definition a type was compiled from, e.g. "Morphe model `Person` from
+// `person.mod`", leaving out the file if it is not known.
+func getMorpheSourceDocs(definitionKind string, definitionName string, sourceFileName string) []string {
+	if sourceFileName == "" {
+		return []string{
+			fmt.Sprintf("Morphe %s `%s`", definitionKind, definitionName),
+		}
+	}
+	return []string{
+		fmt.Sprintf("Morphe %s `%s` from `%s`", definitionKind, definitionName, sourceFileName),
+	}
+}
+
+func getMorpheModelSourceDocs(config cfg.MorpheModelsConfig, modelName string) []string {
+	return getMorpheSourceDocs("model", modelName, config.SourceFileNames[modelName])
+}
+
+func getMorpheEntitySourceDocs(config cfg.MorpheEntitiesConfig, entityName string) []string {
+	return getMorpheSourceDocs("entity", entityName, config.SourceFileNames[entityName])
+}
+
+func getMorpheStructureSourceDocs(config cfg.MorpheStructuresConfig, structureName string) []string {
+	return getMorpheSourceDocs("structure", structureName, config.SourceFileNames[structureName])
+}
+
+func getMorpheEnumSourceDocs(config cfg.MorpheEnumsConfig, enumName string) []string {
+	return getMorpheSourceDocs("enum", enumName, config.SourceFileNames[enumName])
+}
+
+// getMorpheFieldDocs documents the attributes of a field, e.g. "Attributes: `immutable`, `mandatory`".
+func getMorpheFieldDocs(allAttributes []string) []string {
+	if len(allAttributes) == 0 {
+		return nil
+	}
+	return []string{
+		"Attributes: " + getDocsCodeList(allAttributes),
+	}
+}
+
+// getMorpheEntityFieldDocs documents the model field path and the attributes of an entity field.
+func getMorpheEntityFieldDocs(fieldPath string, allAttributes []string) []string {
+	allDocs := []string{
+		fmt.Sprintf("From `%s`", fieldPath),
+	}
+	return append(allDocs, getMorpheFieldDocs(allAttributes)...)
+}
+
+// getMorpheRelationDocs documents the relation a field was generated for, e.g. "`HasManyPoly` relation to `Comment`
+// through `Commentable`" or "`ForOnePoly` relation for `Person`, `Company`".
+func getMorpheRelationDocs(relationType string, relationshipName string, aliased string, allForNames []string, through string) []string {
+	relationDoc := fmt.Sprintf("`%s` relation", relationType)
+	if yamlops.IsRelationPolyFor(relationType) {
+		relationDoc += " for " + getDocsCodeList(allForNames)
+	} else {
+		relationDoc += fmt.Sprintf(" to `%s`", yamlops.GetRelationTargetName(relationshipName, aliased))
+	}
+	if through != "" {
+		relationDoc += fmt.Sprintf(" through `%s`", through)
+	}
+	return []string{
+		relationDoc,
+	}
+}
+
+// getObjectFieldsWithDocs sets the same docs on all fields generated for a single Morphe field or relation.
+func getObjectFieldsWithDocs(allFields []tsdef.ObjectField, allDocs []string) []tsdef.ObjectField {
+	for fieldIdx := range allFields {
+		allFields[fieldIdx].Docs = allDocs
+	}
+	return allFields
+}
+
+func getDocsCodeList(allNames []string) string {
+	allCodeNames := []string{}
+	for _, name := range allNames {
+		allCodeNames = append(allCodeNames, "`"+name+"`")
+	}
+	return strings.Join(allCodeNames, ", ")
+}
+
+// getJsDocLines renders the docs as a JSDoc comment, on a single line if there is only one line of docs.
+func getJsDocLines(indent string, allDocs []string) []string {
+	if len(allDocs) == 0 {
+		return nil
+	}
+	if len(allDocs) == 1 {
+		return []string{
+			fmt.Sprintf("%s/** %s */", indent, getJsDocEscaped(allDocs[0])),
+		}
+	}
+
+	allDocLines := []string{
+		indent + "/**",
+	}
+	for _, doc := range allDocs {
+		if doc == "" {
+			allDocLines = append(allDocLines, indent+" *")
+			continue
+		}
+		allDocLines = append(allDocLines, indent+" * "+getJsDocEscaped(doc))
+	}
+	return append(allDocLines, indent+" */")
+}
+
+// getJsDocEscaped keeps docs from closing the comment early.
+func getJsDocEscaped(doc string) string {
+	return strings.ReplaceAll(doc, "*/", `*\/`)
+}
@@ -14,9 +14,16 @@ import (
 )
 
 func AllMorpheEntitiesToTsObjects(config MorpheCompileConfig, r *registry.Registry) (map[string][]*tsdef.Object, error) {
+	entitiesConfig := config.MorpheEntitiesConfig
+	allSourceFileNames, sourceFileNamesErr := getMorpheSourceFileNamesOrLoad(entitiesConfig.SourceFileNames, config.RegistryEntitiesDirPath, registry.EntityFileSuffix)
+	if sourceFileNamesErr != nil {
+		return nil, sourceFileNamesErr
+	}
+	entitiesConfig.SourceFileNames = allSourceFileNames
+
 	allEntityTypeDefs := map[string][]*tsdef.Object{}
 	for entityName, entity := range r.GetAllEntities() {
-		entityTypes, entityTypesErr := MorpheEntityToTsObjects(config.EntityHooks, config.MorpheEntitiesConfig, r, entity)
+		entityTypes, entityTypesErr := MorpheEntityToTsObjects(config.EntityHooks, entitiesConfig, config.MorpheModelsConfig, config.OutputLayout, r, entity)
 		if entityTypesErr != nil {
 			return nil, entityTypesErr
 		}
@@ -25,7 +32,17 @@ func AllMorpheEntitiesToTsObjects(config MorpheCompileConfig, r *registry.Regist
 	return allEntityTypeDefs, nil
 }
 
-func MorpheEntityToTsObjects(entityHooks hook.CompileMorpheEntity, config cfg.MorpheEntitiesConfig, r *registry.Registry, entity yaml.Entity) ([]*tsdef.Object, error) {
+// morpheEntityCompileConfig is the entities config alongside the models config and output layout, since entity fields
+// reference the compiled model, enum and structure types.
+type morpheEntityCompileConfig struct {
+	cfg.MorpheEntitiesConfig
+	ModelsConfig cfg.MorpheModelsConfig
+	OutputLayout cfg.OutputLayout
+}
+
+// MorpheEntityToTsObjects compiles an entity with the models config and output layout the models are compiled with,
+// which branded ID fields are imported from.
+func MorpheEntityToTsObjects(entityHooks hook.CompileMorpheEntity, config cfg.MorpheEntitiesConfig, modelsConfig cfg.MorpheModelsConfig, outputLayout cfg.OutputLayout, r *registry.Registry, entity yaml.Entity) ([]*tsdef.Object, error) {
 	if r == nil {
 		return nil, triggerCompileMorpheEntityFailure(entityHooks, config, entity, ErrNoRegistry)
 	}
@@ -35,7 +52,12 @@ func MorpheEntityToTsObjects(entityHooks hook.CompileMorpheEntity, config cfg.Mo
 		return nil, triggerCompileMorpheEntityFailure(entityHooks, config, entity, compileStartErr)
 	}
 
-	allEntityTypes, objectsErr := morpheEntityToTsObjectTypes(config, r, entity)
+	compileConfig := morpheEntityCompileConfig{
+		MorpheEntitiesConfig: config,
+		ModelsConfig:         modelsConfig,
+		OutputLayout:         outputLayout,
+	}
+	allEntityTypes, objectsErr := morpheEntityToTsObjectTypes(compileConfig, r, entity)
 	if objectsErr != nil {
 		return nil, triggerCompileMorpheEntityFailure(entityHooks, config, entity, objectsErr)
 	}
@@ -48,17 +70,25 @@ func MorpheEntityToTsObjects(entityHooks hook.CompileMorpheEntity, config cfg.Mo
 	return allEntityTypes, nil
 }
 
-func morpheEntityToTsObjectTypes(config cfg.MorpheEntitiesConfig, r *registry.Registry, entity yaml.Entity) ([]*tsdef.Object, error) {
+func morpheEntityToTsObjectTypes(config morpheEntityCompileConfig, r *registry.Registry, entity yaml.Entity) ([]*tsdef.Object, error) {
 	validateConfigErr := config.Validate()
 	if validateConfigErr != nil {
 		return nil, validateConfigErr
 	}
-	validateMorpheErr := entity.Validate(r.GetAllEntities(), r.GetAllModels(), r.GetAllEnums())
+	modelTypeNamingErr := config.ModelsConfig.TypeNaming.Validate()
+	if modelTypeNamingErr != nil {
+		return nil, modelTypeNamingErr
+	}
+	validateLayoutErr := config.OutputLayout.Validate()
+	if validateLayoutErr != nil {
+		return nil, validateLayoutErr
+	}
+	validateMorpheErr := getMorpheEntityForValidation(r, entity).Validate(r.GetAllEntities(), r.GetAllModels(), getMorpheFieldTypeValidationEnums(r))
 	if validateMorpheErr != nil {
 		return nil, validateMorpheErr
 	}
 
-	entityType, entityTypeErr := getEntityObjectType(r, entity)
+	entityType, entityTypeErr := getEntityObjectType(config, r, entity)
 	if entityTypeErr != nil {
 		return nil, entityTypeErr
 	}
@@ -72,6 +102,22 @@ func morpheEntityToTsObjectTypes(config cfg.MorpheEntitiesConfig, r *registry.Re
 		entityType,
 	}
 	allEntityTypes = append(allEntityTypes, allIdentifierTypes...)
+
+	allUnionTypes, unionTypesErr := getAllEntityPolymorphicUnionObjectTypes(config, r, entity, entityType)
+	if unionTypesErr != nil {
+		return nil, unionTypesErr
+	}
+	for _, unionType := range allUnionTypes {
+		for _, unionField := range unionType.Fields {
+			entityType.Imports = mergeObjectImports(entityType.Imports, unionField.Type.GetImports())
+		}
+	}
+	allEntityTypes = append(allEntityTypes, allUnionTypes...)
+
+	identifiersErr := validateTsObjectIdentifiers(config.IdentifierHandling, getMorpheEntityIdentifierSource(entity), allEntityTypes)
+	if identifiersErr != nil {
+		return nil, identifiersErr
+	}
 	return allEntityTypes, nil
 }
 
@@ -97,12 +143,13 @@ func getAllEntityIdentifierObjectTypes(entity yaml.Entity, entityType *tsdef.Obj
 	return allIdentTypes, nil
 }
 
-func getEntityObjectType(r *registry.Registry, entity yaml.Entity) (*tsdef.Object, error) {
+func getEntityObjectType(config morpheEntityCompileConfig, r *registry.Registry, entity yaml.Entity) (*tsdef.Object, error) {
 	entityType := tsdef.Object{
-		Name: entity.Name,
+		Name: config.TypeNaming.GetTypeName(entity.Name),
+		Docs: getMorpheEntitySourceDocs(config.MorpheEntitiesConfig, entity.Name),
 	}
 
-	typeFields, fieldsErr := getTsFieldsForMorpheEntity(r, entity.Fields, entity.Related)
+	typeFields, fieldsErr := getTsFieldsForMorpheEntity(config, r, entity)
 	if fieldsErr != nil {
 		return nil, fieldsErr
 	}
@@ -135,10 +182,7 @@ func getEntityIdentifierObjectFieldSubset(entityType tsdef.Object, identifierNam
 			if entityFieldDef.Name != tsFieldName {
 				continue
 			}
-			identifierFieldDef = tsdef.ObjectField{
-				Name: entityFieldDef.Name,
-				Type: entityFieldDef.Type,
-			}
+			identifierFieldDef = entityFieldDef
 		}
 		if identifierFieldDef.Name == "" {
 			return nil, ErrMissingMorpheIdentifierField(entityType.Name, identifierName, fieldName)
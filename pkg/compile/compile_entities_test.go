@@ -156,7 +156,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects() {
 	}
 	r.SetEntity("BasicParent", entity1)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
@@ -169,35 +169,52 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects() {
 
 	tsField00 := tsFields0[0]
 	suite.Equal(tsField00.Name, "autoIncrement")
-	suite.Equal(tsField00.Type, tsdef.TsTypeNumber)
+	suite.Equal(tsField00.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeNumber,
+	})
 
 	tsField01 := tsFields0[1]
 	suite.Equal(tsField01.Name, "boolean")
-	suite.Equal(tsField01.Type, tsdef.TsTypeBoolean)
+	suite.Equal(tsField01.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeBoolean,
+	})
 
 	tsField02 := tsFields0[2]
 	suite.Equal(tsField02.Name, "date")
-	suite.Equal(tsField02.Type, tsdef.TsTypeDate)
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeDate,
+	})
+	suite.True(tsField02.DateOnly)
 
 	tsField03 := tsFields0[3]
 	suite.Equal(tsField03.Name, "float")
-	suite.Equal(tsField03.Type, tsdef.TsTypeNumber)
+	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeNumber,
+	})
 
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "integer")
-	suite.Equal(tsField04.Type, tsdef.TsTypeNumber)
+	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeNumber,
+	})
 
 	tsField05 := tsFields0[5]
 	suite.Equal(tsField05.Name, "string")
-	suite.Equal(tsField05.Type, tsdef.TsTypeString)
+	suite.Equal(tsField05.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
 
 	tsField06 := tsFields0[6]
 	suite.Equal(tsField06.Name, "time")
-	suite.Equal(tsField06.Type, tsdef.TsTypeDate)
+	suite.Equal(tsField06.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeDate,
+	})
+	suite.False(tsField06.DateOnly)
 
 	tsField07 := tsFields0[7]
 	suite.Equal(tsField07.Name, "uuid")
 	suite.Equal(tsField07.Type, tsdef.TsTypeString)
+	suite.True(tsField07.Readonly)
 
 	tsObject1 := allTsObjects[1]
 	suite.Equal(tsObject1.Name, "UserIDPrimary")
@@ -208,6 +225,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects() {
 	tsField10 := tsFields1[0]
 	suite.Equal(tsField10.Name, "uuid")
 	suite.Equal(tsField10.Type, tsdef.TsTypeString)
+	suite.True(tsField10.Readonly)
 }
 
 func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_NoEntityName() {
@@ -231,7 +249,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_NoEntityName(
 
 	r := registry.NewRegistry()
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "entity has no name")
@@ -271,7 +289,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_NoFields() {
 	}
 	r.SetModel("Basic", model0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "morphe entity Basic has no fields")
@@ -311,33 +329,39 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_NoIdentifiers
 	}
 	r.SetModel("Basic", model0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "entity 'Basic' has no identifiers")
 	suite.Nil(allTsObjects)
 }
 
-func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_EnumField() {
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_FieldPaths() {
 	entityHooks := hook.CompileMorpheEntity{}
 	entitiesConfig := cfg.MorpheEntitiesConfig{}
 
 	entity0 := yaml.Entity{
-		Name: "User",
+		Name: "Company",
 		Fields: map[string]yaml.EntityField{
-			"UUID": {
-				Type: "User.UUID",
+			"ID": {
+				Type: "Company.ID",
+			},
+			"MainContactEmail": {
+				Type: "Company.MainContact.Email",
+			},
+			"MainContactPhone": {
+				Type: "Company.MainContact.Phone",
 				Attributes: []string{
-					"immutable",
+					"mandatory",
 				},
 			},
-			"Nationality": {
-				Type: "User.Nationality",
+			"EmployeeEmails": {
+				Type: "Company.Employee.WorkContact.Email",
 			},
 		},
 		Identifiers: map[string]yaml.EntityIdentifier{
 			"primary": {
-				Fields: []string{"UUID"},
+				Fields: []string{"ID"},
 			},
 		},
 		Related: map[string]yaml.EntityRelation{},
@@ -345,140 +369,230 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_EnumField() {
 
 	r := registry.NewRegistry()
 
-	userModel := yaml.Model{
-		Name: "User",
+	companyModel := yaml.Model{
+		Name: "Company",
 		Fields: map[string]yaml.ModelField{
-			"UUID": {
-				Type: yaml.ModelFieldTypeUUID,
-				Attributes: []string{
-					"immutable",
-				},
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
 			},
-			"Nationality": {
-				Type: "Nationality",
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"MainContact": {
+				Type:    "ForOne",
+				Aliased: "Contact",
+			},
+			"Employee": {
+				Type:    "HasMany",
+				Aliased: "Person",
+			},
+		},
+	}
+	r.SetModel("Company", companyModel)
+
+	personModel := yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
 			},
 		},
 		Identifiers: map[string]yaml.ModelIdentifier{
 			"primary": {
-				Fields: []string{"UUID"},
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"WorkContact": {
+				Type:    "ForOne",
+				Aliased: "Contact",
 			},
 		},
-		Related: map[string]yaml.ModelRelation{},
 	}
-	r.SetModel("User", userModel)
+	r.SetModel("Person", personModel)
 
-	enum0 := yaml.Enum{
-		Name: "Nationality",
-		Type: yaml.EnumTypeString,
-		Entries: map[string]any{
-			"US": "American",
-			"DE": "German",
-			"FR": "French",
+	contactModel := yaml.Model{
+		Name: "Contact",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"Email": {
+				Type: yaml.ModelFieldTypeString,
+			},
+			"Phone": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
 		},
 	}
-	r.SetEnum("Nationality", enum0)
+	r.SetModel("Contact", contactModel)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
 
 	tsObject0 := allTsObjects[0]
-	suite.Equal(tsObject0.Name, "User")
+	suite.Equal(tsObject0.Name, "Company")
 
 	tsFields0 := tsObject0.Fields
-	suite.Len(tsFields0, 2)
+	suite.Len(tsFields0, 4)
 
 	tsField00 := tsFields0[0]
-	suite.Equal(tsField00.Name, "nationality")
-	suite.Equal(tsField00.Type, tsdef.TsTypeObject{
-		ModulePath: "../enums/nationality",
-		Name:       "Nationality",
+	suite.Equal(tsField00.Name, "employeeEmails")
+	suite.Equal(tsField00.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeArray{
+			ValueType: tsdef.TsTypeString,
+		},
 	})
 
 	tsField01 := tsFields0[1]
-	suite.Equal(tsField01.Name, "uuid")
-	suite.Equal(tsField01.Type, tsdef.TsTypeString)
-
-	tsObject1 := allTsObjects[1]
-	suite.Equal(tsObject1.Name, "UserIDPrimary")
+	suite.Equal(tsField01.Name, "id")
+	suite.Equal(tsField01.Type, tsdef.TsTypeNumber)
 
-	tsFields1 := tsObject1.Fields
-	suite.Len(tsFields1, 1)
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "mainContactEmail")
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
 
-	tsField10 := tsFields1[0]
-	suite.Equal(tsField10.Name, "uuid")
-	suite.Equal(tsField10.Type, tsdef.TsTypeString)
+	tsField03 := tsFields0[3]
+	suite.Equal(tsField03.Name, "mainContactPhone")
+	suite.Equal(tsField03.Type, tsdef.TsTypeString)
 }
 
-func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_EnumField_EnumNotFound() {
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_FieldPaths_Polymorphic() {
 	entityHooks := hook.CompileMorpheEntity{}
 	entitiesConfig := cfg.MorpheEntitiesConfig{}
 
 	entity0 := yaml.Entity{
-		Name: "User",
+		Name: "Comment",
 		Fields: map[string]yaml.EntityField{
-			"UUID": {
-				Type: "User.UUID",
-				Attributes: []string{
-					"immutable",
-				},
+			"ID": {
+				Type: "Comment.ID",
 			},
-			"Nationality": {
-				Type: "User.Nationality",
+			"CommentableID": {
+				Type: "Comment.Commentable.ID",
+			},
+			"CommentableName": {
+				Type: "Comment.Commentable.Name",
 			},
 		},
 		Identifiers: map[string]yaml.EntityIdentifier{
 			"primary": {
-				Fields: []string{"UUID"},
+				Fields: []string{"ID"},
 			},
 		},
 		Related: map[string]yaml.EntityRelation{},
 	}
 
 	r := registry.NewRegistry()
-
-	userModel := yaml.Model{
-		Name: "User",
+	r.SetModel("Comment", yaml.Model{
+		Name: "Comment",
 		Fields: map[string]yaml.ModelField{
-			"UUID": {
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Commentable": {
+				Type: "ForOnePoly",
+				For:  []string{"Person", "Company"},
+			},
+		},
+	})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"Name": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	})
+	r.SetModel("Company", yaml.Model{
+		Name: "Company",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
 				Type: yaml.ModelFieldTypeUUID,
-				Attributes: []string{
-					"immutable",
-				},
 			},
-			"Nationality": {
-				Type: "Nationality",
+			"Name": {
+				Type: yaml.ModelFieldTypeString,
 			},
 		},
 		Identifiers: map[string]yaml.ModelIdentifier{
 			"primary": {
-				Fields: []string{"UUID"},
+				Fields: []string{"ID"},
 			},
 		},
-		Related: map[string]yaml.ModelRelation{},
-	}
-	r.SetModel("User", userModel)
+	})
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
-	suite.NotNil(tsObjectErr)
-	suite.ErrorContains(tsObjectErr, "morphe entity 'User' field 'Nationality' has unknown non-primitive type 'Nationality'")
-	suite.Nil(allTsObjects)
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Comment")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 3)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "commentableID")
+	suite.Equal(tsField00.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsdef.TsTypeNumber,
+				tsdef.TsTypeString,
+			},
+		},
+	})
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "commentableName")
+	suite.Equal(tsField01.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "id")
+	suite.Equal(tsField02.Type, tsdef.TsTypeNumber)
 }
 
-func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOne() {
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_FieldPaths_Polymorphic_TargetMissingField() {
 	entityHooks := hook.CompileMorpheEntity{}
 	entitiesConfig := cfg.MorpheEntitiesConfig{}
 
 	entity0 := yaml.Entity{
-		Name: "Basic",
+		Name: "Comment",
 		Fields: map[string]yaml.EntityField{
 			"ID": {
-				Type: "Basic.ID",
+				Type: "Comment.ID",
 			},
-			"String": {
-				Type: "Basic.String",
+			"CommentableName": {
+				Type: "Comment.Commentable.Name",
 			},
 		},
 		Identifiers: map[string]yaml.EntityIdentifier{
@@ -486,24 +600,16 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 				Fields: []string{"ID"},
 			},
 		},
-		Related: map[string]yaml.EntityRelation{
-			"BasicParent": {
-				Type: "ForOne",
-			},
-		},
+		Related: map[string]yaml.EntityRelation{},
 	}
 
 	r := registry.NewRegistry()
-
-	model0 := yaml.Model{
-		Name: "Basic",
+	r.SetModel("Comment", yaml.Model{
+		Name: "Comment",
 		Fields: map[string]yaml.ModelField{
 			"ID": {
 				Type: yaml.ModelFieldTypeAutoIncrement,
 			},
-			"String": {
-				Type: yaml.ModelFieldTypeString,
-			},
 		},
 		Identifiers: map[string]yaml.ModelIdentifier{
 			"primary": {
@@ -511,20 +617,19 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 			},
 		},
 		Related: map[string]yaml.ModelRelation{
-			"BasicParent": {
-				Type: "ForOne",
+			"Commentable": {
+				Type: "ForOnePoly",
+				For:  []string{"Person", "Post"},
 			},
 		},
-	}
-	r.SetModel("Basic", model0)
-
-	model1 := yaml.Model{
-		Name: "BasicParent",
+	})
+	r.SetModel("Person", yaml.Model{
+		Name: "Person",
 		Fields: map[string]yaml.ModelField{
 			"ID": {
 				Type: yaml.ModelFieldTypeAutoIncrement,
 			},
-			"String": {
+			"Name": {
 				Type: yaml.ModelFieldTypeString,
 			},
 		},
@@ -533,83 +638,259 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 				Fields: []string{"ID"},
 			},
 		},
-		Related: map[string]yaml.ModelRelation{
-			"Basic": {
-				Type: "HasOne",
-			},
+	})
+	r.SetModel("Post", yaml.Model{
+		Name: "Post",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"Title": {
+				Type: yaml.ModelFieldTypeString,
+			},
 		},
-	}
-	r.SetModel("BasicParent", model1)
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	})
 
-	entity1 := yaml.Entity{
-		Name: "BasicParent",
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
+
+	suite.ErrorContains(tsObjectErr, "polymorphic relation Commentable target Post: terminal field not found: Name in path Comment.Commentable.Name")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_EnumField() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{}
+
+	entity0 := yaml.Entity{
+		Name: "User",
 		Fields: map[string]yaml.EntityField{
-			"ID": {
-				Type: "BasicParent.ID",
+			"UUID": {
+				Type: "User.UUID",
+				Attributes: []string{
+					"immutable",
+				},
 			},
-			"String": {
-				Type: "BasicParent.String",
+			"Nationality": {
+				Type: "User.Nationality",
 			},
 		},
 		Identifiers: map[string]yaml.EntityIdentifier{
 			"primary": {
-				Fields: []string{"ID"},
+				Fields: []string{"UUID"},
 			},
 		},
-		Related: map[string]yaml.EntityRelation{
-			"Basic": {
-				Type: "HasMany",
+		Related: map[string]yaml.EntityRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	userModel := yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+				Attributes: []string{
+					"immutable",
+				},
+			},
+			"Nationality": {
+				Type: "Nationality",
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
 			},
 		},
+		Related: map[string]yaml.ModelRelation{},
 	}
-	r.SetEntity("BasicParent", entity1)
+	r.SetModel("User", userModel)
+
+	enum0 := yaml.Enum{
+		Name: "Nationality",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"US": "American",
+			"DE": "German",
+			"FR": "French",
+		},
+	}
+	r.SetEnum("Nationality", enum0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
 
 	tsObject0 := allTsObjects[0]
-	suite.Equal(tsObject0.Name, "Basic")
+	suite.Equal(tsObject0.Name, "User")
 
 	tsFields0 := tsObject0.Fields
-	suite.Len(tsFields0, 4)
+	suite.Len(tsFields0, 2)
 
 	tsField00 := tsFields0[0]
-	suite.Equal(tsField00.Name, "id")
-	suite.Equal(tsField00.Type, tsdef.TsTypeNumber)
+	suite.Equal(tsField00.Name, "nationality")
+	suite.Equal(tsField00.Type, tsdef.TsTypeObject{
+		ModulePath: "../enums/nationality",
+		Name:       "Nationality",
+	})
 
 	tsField01 := tsFields0[1]
-	suite.Equal(tsField01.Name, "string")
+	suite.Equal(tsField01.Name, "uuid")
 	suite.Equal(tsField01.Type, tsdef.TsTypeString)
 
-	tsField02 := tsFields0[2]
-	suite.Equal(tsField02.Name, "basicParentID")
-	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeNumber,
-	})
-
-	tsField03 := tsFields0[3]
-	suite.Equal(tsField03.Name, "basicParent")
-	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeObject{
-			ModulePath: "./basic-parent",
-			Name:       "BasicParent",
-		},
-	})
-
 	tsObject1 := allTsObjects[1]
-	suite.Equal(tsObject1.Name, "BasicIDPrimary")
+	suite.Equal(tsObject1.Name, "UserIDPrimary")
 
 	tsFields1 := tsObject1.Fields
 	suite.Len(tsFields1, 1)
 
 	tsField10 := tsFields1[0]
-	suite.Equal(tsField10.Name, "id")
-	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
+	suite.Equal(tsField10.Name, "uuid")
+	suite.Equal(tsField10.Type, tsdef.TsTypeString)
 }
 
-func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMany() {
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_StructureField() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{}
+	outputLayout := cfg.OutputLayout{
+		StructuresDirName: "values",
+	}
+
+	entity0 := yaml.Entity{
+		Name: "User",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "User.UUID",
+			},
+			"HomeAddress": {
+				Type: "User.HomeAddress",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	userModel := yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+			"HomeAddress": {
+				Type: "Address",
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+	r.SetModel("User", userModel)
+
+	structure0 := yaml.Structure{
+		Name: "Address",
+		Fields: map[string]yaml.StructureField{
+			"Street": {
+				Type: yaml.StructureFieldTypeString,
+			},
+		},
+	}
+	r.SetStructure("Address", structure0)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, outputLayout, r, entity0)
+
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "User")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 2)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "homeAddress")
+	suite.Equal(tsField00.Type, tsdef.TsTypeObject{
+		ModulePath: "../values/address",
+		Name:       "Address",
+	})
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "uuid")
+	suite.Equal(tsField01.Type, tsdef.TsTypeString)
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_EnumField_EnumNotFound() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{}
+
+	entity0 := yaml.Entity{
+		Name: "User",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "User.UUID",
+				Attributes: []string{
+					"immutable",
+				},
+			},
+			"Nationality": {
+				Type: "User.Nationality",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	userModel := yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+				Attributes: []string{
+					"immutable",
+				},
+			},
+			"Nationality": {
+				Type: "Nationality",
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+	r.SetModel("User", userModel)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
+
+	suite.NotNil(tsObjectErr)
+	suite.ErrorContains(tsObjectErr, "morphe entity 'User' field 'Nationality' has unknown non-primitive type 'Nationality'")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOne() {
 	entityHooks := hook.CompileMorpheEntity{}
 	entitiesConfig := cfg.MorpheEntitiesConfig{}
 
@@ -630,7 +911,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 		},
 		Related: map[string]yaml.EntityRelation{
 			"BasicParent": {
-				Type: "ForMany",
+				Type: "ForOne",
 			},
 		},
 	}
@@ -654,7 +935,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 		},
 		Related: map[string]yaml.ModelRelation{
 			"BasicParent": {
-				Type: "ForMany",
+				Type: "ForOne",
 			},
 		},
 	}
@@ -677,7 +958,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 		},
 		Related: map[string]yaml.ModelRelation{
 			"Basic": {
-				Type: "HasMany",
+				Type: "HasOne",
 			},
 		},
 	}
@@ -706,7 +987,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 	}
 	r.SetEntity("BasicParent", entity1)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
@@ -726,21 +1007,17 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 	suite.Equal(tsField01.Type, tsdef.TsTypeString)
 
 	tsField02 := tsFields0[2]
-	suite.Equal(tsField02.Name, "basicParentIDs")
+	suite.Equal(tsField02.Name, "basicParentID")
 	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeNumber,
-		},
+		ValueType: tsdef.TsTypeNumber,
 	})
 
 	tsField03 := tsFields0[3]
-	suite.Equal(tsField03.Name, "basicParents")
+	suite.Equal(tsField03.Name, "basicParent")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeObject{
-				ModulePath: "./basic-parent",
-				Name:       "BasicParent",
-			},
+		ValueType: tsdef.TsTypeObject{
+			ModulePath: "./basic-parent",
+			Name:       "BasicParent",
 		},
 	})
 
@@ -755,7 +1032,153 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
 }
 
-func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasOne() {
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMany() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{}
+
+	entity0 := yaml.Entity{
+		Name: "Basic",
+		Fields: map[string]yaml.EntityField{
+			"ID": {
+				Type: "Basic.ID",
+			},
+			"String": {
+				Type: "Basic.String",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{
+			"BasicParent": {
+				Type: "ForMany",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"String": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"BasicParent": {
+				Type: "ForMany",
+			},
+		},
+	}
+	r.SetModel("Basic", model0)
+
+	model1 := yaml.Model{
+		Name: "BasicParent",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"String": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Basic": {
+				Type: "HasMany",
+			},
+		},
+	}
+	r.SetModel("BasicParent", model1)
+
+	entity1 := yaml.Entity{
+		Name: "BasicParent",
+		Fields: map[string]yaml.EntityField{
+			"ID": {
+				Type: "BasicParent.ID",
+			},
+			"String": {
+				Type: "BasicParent.String",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{
+			"Basic": {
+				Type: "HasMany",
+			},
+		},
+	}
+	r.SetEntity("BasicParent", entity1)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
+
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Basic")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 4)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "id")
+	suite.Equal(tsField00.Type, tsdef.TsTypeNumber)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "string")
+	suite.Equal(tsField01.Type, tsdef.TsTypeString)
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "basicParentIDs")
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeArray{
+			ValueType: tsdef.TsTypeNumber,
+		},
+	})
+
+	tsField03 := tsFields0[3]
+	suite.Equal(tsField03.Name, "basicParents")
+	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeArray{
+			ValueType: tsdef.TsTypeObject{
+				ModulePath: "./basic-parent",
+				Name:       "BasicParent",
+			},
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicIDPrimary")
+
+	tsFields1 := tsObject1.Fields
+	suite.Len(tsFields1, 1)
+
+	tsField10 := tsFields1[0]
+	suite.Equal(tsField10.Name, "id")
+	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasOne() {
 	entityHooks := hook.CompileMorpheEntity{}
 	entitiesConfig := cfg.MorpheEntitiesConfig{}
 
@@ -852,7 +1275,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasOn
 	}
 	r.SetEntity("Basic", entity1)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
@@ -994,7 +1417,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasMa
 	}
 	r.SetEntity("Basic", entity1)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
@@ -1086,7 +1509,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_StartHook_Suc
 	}
 	r.SetModel("Basic", model0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.True(hookCalled)
 	suite.Nil(tsObjectErr)
@@ -1139,7 +1562,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_StartHook_Fai
 	}
 	r.SetModel("Basic", model0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "compile entity start hook error")
@@ -1201,7 +1624,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_SuccessHook_S
 	}
 	r.SetModel("Basic", model0)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.Nil(tsObjectErr)
 	suite.Len(allTsObjects, 2)
@@ -1279,7 +1702,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_SuccessHook_F
 	}
 	r.SetModel("User", userModel)
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "compile entity success hook error")
@@ -1312,7 +1735,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_FailureHook_U
 
 	r := registry.NewRegistry()
 
-	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, entity0)
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
 
 	suite.NotNil(tsObjectErr)
 	suite.ErrorContains(tsObjectErr, "Entity User: morphe entity User field UUID references unknown root model: NonExistentModel")
@@ -1457,7 +1880,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 	r.SetEntity("Person", personEntity)
 	r.SetEntity("Company", companyEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, commentEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, commentEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1485,13 +1908,18 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 	tsField03 := tsFields0[3]
 	suite.Equal(tsField03.Name, "commentableID")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeString,
+		ValueType: tsdef.TsTypeNumber,
 	})
 
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "commentableType")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeString,
+		ValueType: tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsdef.TsTypeStringLiteral{Value: "Person"},
+				tsdef.TsTypeStringLiteral{Value: "Company"},
+			},
+		},
 	})
 
 	tsField05 := tsFields0[5]
@@ -1654,7 +2082,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 	r.SetEntity("Person", personEntity)
 	r.SetEntity("Company", companyEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, tagEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, tagEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1679,14 +2107,19 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForMa
 	suite.Equal(tsField02.Name, "taggableIDs")
 	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
 		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeString,
+			ValueType: tsdef.TsTypeNumber,
 		},
 	})
 
 	tsField03 := tsFields0[3]
 	suite.Equal(tsField03.Name, "taggableType")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeString,
+		ValueType: tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsdef.TsTypeStringLiteral{Value: "Person"},
+				tsdef.TsTypeStringLiteral{Value: "Company"},
+			},
+		},
 	})
 
 	tsField04 := tsFields0[4]
@@ -1831,7 +2264,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasOn
 	r.SetEntity("Comment", commentEntity)
 	r.SetEntity("Person", personEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, personEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, personEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1865,9 +2298,13 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasOn
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "comment")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeObject{
-			ModulePath: "./comment",
-			Name:       "Comment",
+		ValueType: tsdef.TsTypeNarrowed{
+			ValueType: tsdef.TsTypeObject{
+				ModulePath: "./comment",
+				Name:       "Comment",
+			},
+			FieldName: "commentableType",
+			FieldType: tsdef.TsTypeStringLiteral{Value: "Person"},
 		},
 	})
 
@@ -1994,7 +2431,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasMa
 	r.SetEntity("Tag", tagEntity)
 	r.SetEntity("Person", personEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, personEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, personEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -2031,9 +2468,13 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasMa
 	suite.Equal(tsField04.Name, "tags")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
 		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeObject{
-				ModulePath: "./tag",
-				Name:       "Tag",
+			ValueType: tsdef.TsTypeNarrowed{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./tag",
+					Name:       "Tag",
+				},
+				FieldName: "taggableType",
+				FieldType: tsdef.TsTypeStringLiteral{Value: "Person"},
 			},
 		},
 	})
@@ -2151,7 +2592,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_ForOn
 	r.SetEntity("Contact", contactEntity)
 	r.SetEntity("Person", personEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, personEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, personEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -2317,7 +2758,7 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasMa
 	r.SetEntity("Project", projectEntity)
 	r.SetEntity("Person", personEntity)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, r, personEntity)
+	allTsObjects, allTsObjectsErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, personEntity)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -2387,3 +2828,345 @@ func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_Related_HasMa
 	suite.Equal(tsField10.Name, "id")
 	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
 }
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_FieldOptionality_Optional() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{
+		FieldOptionality: cfg.FieldOptionalityOptional,
+	}
+
+	entity0 := yaml.Entity{
+		Name: "User",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "User.UUID",
+				Attributes: []string{
+					"immutable",
+					"mandatory",
+				},
+			},
+			"Nationality": {
+				Type: "User.Nationality",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	userModel := yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+				Attributes: []string{
+					"immutable",
+				},
+			},
+			"Nationality": {
+				Type: "Nationality",
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+	r.SetModel("User", userModel)
+
+	enum0 := yaml.Enum{
+		Name: "Nationality",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"US": "American",
+		},
+	}
+	r.SetEnum("Nationality", enum0)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
+
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "User")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 2)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "nationality")
+	suite.Equal(tsField00.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeObject{
+			ModulePath: "../enums/nationality",
+			Name:       "Nationality",
+		},
+	})
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "uuid")
+	suite.Equal(tsField01.Type, tsdef.TsTypeString)
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "UserIDPrimary")
+
+	tsFields1 := tsObject1.Fields
+	suite.Len(tsFields1, 1)
+
+	tsField10 := tsFields1[0]
+	suite.Equal(tsField10.Name, "uuid")
+	suite.Equal(tsField10.Type, tsdef.TsTypeString)
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_TypeNaming() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{
+		TypeNaming: cfg.TypeNaming{
+			Prefix: "Api",
+			Suffix: "Entity",
+		},
+	}
+
+	entity0 := yaml.Entity{
+		Name: "User",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "User.UUID",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{
+			"Company": {
+				Type: "ForOne",
+			},
+		},
+	}
+	entity1 := yaml.Entity{
+		Name: "Company",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "Company.UUID",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{
+			"User": {
+				Type: "HasMany",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("User", yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Company": {
+				Type: "ForOne",
+			},
+		},
+	})
+	r.SetModel("Company", yaml.Model{
+		Name: "Company",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"User": {
+				Type: "HasMany",
+			},
+		},
+	})
+	r.SetEntity("User", entity0)
+	r.SetEntity("Company", entity1)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, cfg.MorpheModelsConfig{}, cfg.OutputLayout{}, r, entity0)
+
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "ApiUserEntity")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 3)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "companyUUID")
+	suite.Equal(tsField01.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "company")
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeObject{
+			ModulePath: "./api-company-entity",
+			Name:       "ApiCompanyEntity",
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "ApiUserEntityIDPrimary")
+}
+
+func (suite *CompileEntitiesTestSuite) TestMorpheEntityToTsObjects_BrandedIDs() {
+	entityHooks := hook.CompileMorpheEntity{}
+	entitiesConfig := cfg.MorpheEntitiesConfig{}
+	modelsConfig := cfg.MorpheModelsConfig{
+		BrandedIDs: true,
+		TypeNaming: cfg.TypeNaming{
+			Suffix: "Model",
+		},
+	}
+	outputLayout := cfg.OutputLayout{
+		ModelsDirName: "model-types",
+	}
+
+	entity0 := yaml.Entity{
+		Name: "User",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "User.UUID",
+			},
+			"Name": {
+				Type: "User.Name",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.EntityRelation{
+			"Company": {
+				Type: "ForOne",
+			},
+		},
+	}
+	entity1 := yaml.Entity{
+		Name: "Company",
+		Fields: map[string]yaml.EntityField{
+			"UUID": {
+				Type: "Company.UUID",
+			},
+		},
+		Identifiers: map[string]yaml.EntityIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("User", yaml.Model{
+		Name: "User",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+			"Name": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Company": {
+				Type: "ForOne",
+			},
+		},
+	})
+	r.SetModel("Company", yaml.Model{
+		Name: "Company",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"UUID"},
+			},
+		},
+	})
+	r.SetEntity("User", entity0)
+	r.SetEntity("Company", entity1)
+
+	allTsObjects, tsObjectErr := compile.MorpheEntityToTsObjects(entityHooks, entitiesConfig, modelsConfig, outputLayout, r, entity0)
+
+	suite.Nil(tsObjectErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "User")
+	suite.Equal(tsObject0.Fields, []tsdef.ObjectField{
+		{
+			Name: "name",
+			Type: tsdef.TsTypeString,
+			Docs: []string{"From `User.Name`"},
+		},
+		{
+			Name: "uuid",
+			Type: tsdef.TsTypeObject{
+				ModulePath: "../model-types/user-model",
+				Name:       "UserModelID",
+			},
+			Docs: []string{"From `User.UUID`"},
+		},
+		{
+			Name: "companyUUID",
+			Type: tsdef.TsTypeOptional{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "../model-types/company-model",
+					Name:       "CompanyModelID",
+				},
+			},
+			Docs: []string{"`ForOne` relation to `Company`"},
+		},
+		{
+			Name: "company",
+			Type: tsdef.TsTypeOptional{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./company",
+					Name:       "Company",
+				},
+			},
+			Docs: []string{"`ForOne` relation to `Company`"},
+		},
+	})
+}
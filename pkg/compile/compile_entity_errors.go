@@ -27,3 +27,7 @@ var ErrFailedToGetRelatedModel = func(relatedName, fieldType string) error {
 var ErrTerminalFieldNotFound = func(fieldName, fieldType string) error {
 	return fmt.Errorf("terminal field not found: %s in path %s", fieldName, fieldType)
 }
+
+var ErrPolymorphicTargetPathNotResolved = func(relatedName, targetModelName string, err error) error {
+	return fmt.Errorf("polymorphic relation %s target %s: %w", relatedName, targetModelName, err)
+}
@@ -2,6 +2,7 @@ package compile
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/kalo-build/go-util/core"
@@ -10,20 +11,19 @@ import (
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 	"github.com/kalo-build/morphe-go/pkg/yamlops"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
-	"github.com/kalo-build/plugin-morphe-ts-types/pkg/typemap"
 )
 
-func getTsFieldsForMorpheEntity(r *registry.Registry, entityFields map[string]yaml.EntityField, entityRelations map[string]yaml.EntityRelation) ([]tsdef.ObjectField, error) {
+func getTsFieldsForMorpheEntity(config morpheEntityCompileConfig, r *registry.Registry, entity yaml.Entity) ([]tsdef.ObjectField, error) {
 	if r == nil {
 		return nil, ErrNoRegistry
 	}
 
-	allFields, fieldErr := getDirectTsFieldsForMorpheEntity(r, entityFields)
+	allFields, fieldErr := getDirectTsFieldsForMorpheEntity(config, r, entity.Fields)
 	if fieldErr != nil {
 		return nil, fieldErr
 	}
 
-	allRelatedFields, relatedErr := getRelatedTsFieldsForMorpheEntity(r, entityRelations)
+	allRelatedFields, relatedErr := getRelatedTsFieldsForMorpheEntity(config, r, entity)
 	if relatedErr != nil {
 		return nil, relatedErr
 	}
@@ -32,20 +32,29 @@ func getTsFieldsForMorpheEntity(r *registry.Registry, entityFields map[string]ya
 	return allFields, nil
 }
 
-func getDirectTsFieldsForMorpheEntity(r *registry.Registry, entityFields map[string]yaml.EntityField) ([]tsdef.ObjectField, error) {
+func getDirectTsFieldsForMorpheEntity(config morpheEntityCompileConfig, r *registry.Registry, entityFields map[string]yaml.EntityField) ([]tsdef.ObjectField, error) {
 	allFields := []tsdef.ObjectField{}
 	allFieldNames := core.MapKeysSorted(entityFields)
 
 	for _, fieldName := range allFieldNames {
 		fieldDef := entityFields[fieldName]
-		tsType, typeErr := getTsTypeForEntityField(r, fieldDef)
+		tsType, pathType, typeErr := getTsTypeForEntityField(config, r, fieldDef)
 		if typeErr != nil {
 			return nil, typeErr
 		}
 
+		tsType = getTsTypeWithFieldOptionality(config.FieldOptionality, fieldDef.Attributes, tsType)
+		if pathType.isOptional && !tsType.IsOptional() && !hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeMandatory) {
+			tsType = tsdef.TsTypeOptional{
+				ValueType: tsType,
+			}
+		}
 		typeField := tsdef.ObjectField{
-			Name: strcase.ToCamelCase(fieldName),
-			Type: tsType,
+			Name:     strcase.ToCamelCase(fieldName),
+			Type:     tsType,
+			Readonly: hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeImmutable),
+			Docs:     getMorpheEntityFieldDocs(string(fieldDef.Type), fieldDef.Attributes),
+			DateOnly: pathType.isDateOnly,
 		}
 		allFields = append(allFields, typeField)
 	}
@@ -53,66 +62,186 @@ func getDirectTsFieldsForMorpheEntity(r *registry.Registry, entityFields map[str
 	return allFields, nil
 }
 
-func getTsTypeForEntityField(r *registry.Registry, field yaml.EntityField) (tsdef.TsType, error) {
+// entityFieldPathType is the resolved type of an entity field path
+type entityFieldPathType struct {
+	terminalType tsdef.TsType
+	isList       bool
+	isOptional   bool
+	// isDateOnly is set if all terminal fields are Morphe `Date` fields
+	isDateOnly bool
+}
+
+// getTsTypeForEntityField resolves the model field an entity field path points at, e.g. `Person.WorkContact.Email`.
+//
+// Paths through to-many relations resolve to a list of the field type. Paths through to-one relations are optional,
+// since the related model may be absent, just like the relation fields of the model types.
+func getTsTypeForEntityField(config morpheEntityCompileConfig, r *registry.Registry, field yaml.EntityField) (tsdef.TsType, entityFieldPathType, error) {
 	fieldPath := strings.Split(string(field.Type), ".")
 	if len(fieldPath) < 2 {
-		return nil, ErrInvalidEntityFieldPath(string(field.Type))
+		return nil, entityFieldPathType{}, ErrInvalidEntityFieldPath(string(field.Type))
 	}
 
 	rootModelName := fieldPath[0]
-	currentModel, modelErr := r.GetModel(rootModelName)
+	rootModel, modelErr := r.GetModel(rootModelName)
 	if modelErr != nil {
-		return nil, ErrRootModelNotFound(rootModelName)
+		return nil, entityFieldPathType{}, ErrRootModelNotFound(rootModelName)
+	}
+
+	pathType, pathErr := getEntityFieldPathType(config, r, rootModel, fieldPath[1:], string(field.Type))
+	if pathErr != nil {
+		return nil, entityFieldPathType{}, pathErr
+	}
+	if pathType.isList {
+		return tsdef.TsTypeArray{
+			ValueType: pathType.terminalType,
+		}, pathType, nil
+	}
+	return pathType.terminalType, pathType, nil
+}
+
+// getEntityFieldPathType resolves the remaining path segments starting at the given model.
+//
+// Paths through `ForOnePoly` and `ForManyPoly` relations resolve the rest of the path for every `for` target, and are
+// typed with the union of the terminal field types.
+func getEntityFieldPathType(config morpheEntityCompileConfig, r *registry.Registry, model yaml.Model, pathSegments []string, fieldPath string) (entityFieldPathType, error) {
+	if len(pathSegments) == 1 {
+		terminalType, terminalErr := getTsTypeForEntityTerminalField(config, r, model, pathSegments[0], fieldPath)
+		if terminalErr != nil {
+			return entityFieldPathType{}, terminalErr
+		}
+		return entityFieldPathType{
+			terminalType: terminalType,
+			isDateOnly:   model.Fields[pathSegments[0]].Type == yaml.ModelFieldTypeDate,
+		}, nil
+	}
+
+	relatedName := pathSegments[0]
+	modelRelation, exists := model.Related[relatedName]
+	if !exists {
+		return entityFieldPathType{}, ErrRelatedModelNotFound(relatedName, fieldPath)
+	}
+
+	isPolymorphicFor := yamlops.IsRelationPolyFor(modelRelation.Type)
+	allRelatedModelNames := []string{yamlops.GetRelationTargetName(relatedName, modelRelation.Aliased)}
+	if isPolymorphicFor {
+		allRelatedModelNames = modelRelation.For
+	}
+
+	pathType := entityFieldPathType{
+		isList:     yamlops.IsRelationMany(modelRelation.Type),
+		isOptional: !yamlops.IsRelationMany(modelRelation.Type),
+		isDateOnly: true,
+	}
+	allTerminalTypes := []tsdef.TsType{}
+	for _, relatedModelName := range allRelatedModelNames {
+		relatedModel, relatedErr := r.GetModel(relatedModelName)
+		if relatedErr != nil {
+			return entityFieldPathType{}, ErrFailedToGetRelatedModel(relatedModelName, fieldPath)
+		}
+
+		relatedPathType, relatedPathErr := getEntityFieldPathType(config, r, relatedModel, pathSegments[1:], fieldPath)
+		if relatedPathErr != nil && isPolymorphicFor {
+			return entityFieldPathType{}, ErrPolymorphicTargetPathNotResolved(relatedName, relatedModelName, relatedPathErr)
+		}
+		if relatedPathErr != nil {
+			return entityFieldPathType{}, relatedPathErr
+		}
+		allTerminalTypes = append(allTerminalTypes, relatedPathType.terminalType)
+		pathType.isList = pathType.isList || relatedPathType.isList
+		pathType.isOptional = pathType.isOptional || relatedPathType.isOptional
+		pathType.isDateOnly = pathType.isDateOnly && relatedPathType.isDateOnly
+	}
+	pathType.terminalType = getDistinctTsTypeUnion(allTerminalTypes)
+	return pathType, nil
+}
+
+// getMorpheEntityForValidation returns the entity without the fields sourced through polymorphic relations, which
+// Morphe cannot validate. These are validated while resolving their types instead.
+func getMorpheEntityForValidation(r *registry.Registry, entity yaml.Entity) yaml.Entity {
+	allIdentifierFieldNames := []string{}
+	for _, identifier := range entity.Identifiers {
+		allIdentifierFieldNames = append(allIdentifierFieldNames, identifier.Fields...)
 	}
 
+	validationEntity := entity.DeepClone()
+	for fieldName, fieldDef := range entity.Fields {
+		if slices.Contains(allIdentifierFieldNames, fieldName) {
+			continue
+		}
+		if isMorpheEntityFieldPathPolymorphic(r, fieldDef.Type) {
+			delete(validationEntity.Fields, fieldName)
+		}
+	}
+	if len(validationEntity.Fields) == 0 {
+		return entity
+	}
+	return validationEntity
+}
+
+func isMorpheEntityFieldPathPolymorphic(r *registry.Registry, fieldType yaml.ModelFieldPath) bool {
+	fieldPath := strings.Split(string(fieldType), ".")
+	currentModel, modelErr := r.GetModel(fieldPath[0])
+	if modelErr != nil {
+		return false
+	}
 	for segmentIdx := 1; segmentIdx < len(fieldPath)-1; segmentIdx++ {
 		relatedName := fieldPath[segmentIdx]
-		_, exists := currentModel.Related[relatedName]
+		modelRelation, exists := currentModel.Related[relatedName]
 		if !exists {
-			return nil, ErrRelatedModelNotFound(relatedName, string(field.Type))
+			return false
 		}
-
-		relatedModel, relatedErr := r.GetModel(relatedName)
+		if yamlops.IsRelationPoly(modelRelation.Type) {
+			return true
+		}
+		relatedModel, relatedErr := r.GetModel(yamlops.GetRelationTargetName(relatedName, modelRelation.Aliased))
 		if relatedErr != nil {
-			return nil, ErrFailedToGetRelatedModel(relatedName, string(field.Type))
+			return false
 		}
 		currentModel = relatedModel
 	}
+	return false
+}
 
-	terminalFieldName := fieldPath[len(fieldPath)-1]
-	terminalField, exists := currentModel.Fields[terminalFieldName]
+func getTsTypeForEntityTerminalField(config morpheEntityCompileConfig, r *registry.Registry, model yaml.Model, terminalFieldName string, fieldPath string) (tsdef.TsType, error) {
+	terminalField, exists := model.Fields[terminalFieldName]
 	if !exists {
-		return nil, ErrTerminalFieldNotFound(terminalFieldName, string(field.Type))
+		return nil, ErrTerminalFieldNotFound(terminalFieldName, fieldPath)
+	}
+
+	if brandedIDType, isBranded := getEntityFieldBrandedIDType(config, model, terminalFieldName); isBranded {
+		return brandedIDType, nil
 	}
 
-	tsEnumField := getEnumFieldAsTsFieldType(r.GetAllEnums(), terminalFieldName, string(terminalField.Type))
+	tsEnumField := getEnumFieldAsTsFieldType(config.OutputLayout.GetEnumsDirName(), r.GetAllEnums(), terminalFieldName, string(terminalField.Type))
 	if tsEnumField.Name != "" && tsEnumField.Type != nil {
 		return tsEnumField.Type, nil
 	}
 
-	tsFieldType, typeSupported := typemap.MorpheModelFieldToTsField[terminalField.Type]
-	if !typeSupported {
-		return nil, ErrUnsupportedMorpheFieldType(terminalField.Type)
+	tsStructureType, isStructure := getStructureAsTsType("../"+config.OutputLayout.GetStructuresDirName(), r.GetAllStructures(), string(terminalField.Type))
+	if isStructure {
+		return tsStructureType, nil
 	}
-	return tsFieldType, nil
+
+	return getTsTypeForMorpheModelFieldType(config.TypeMappings, terminalField.Type)
 }
 
-func getRelatedTsFieldsForMorpheEntity(r *registry.Registry, entityRelations map[string]yaml.EntityRelation) ([]tsdef.ObjectField, error) {
+func getRelatedTsFieldsForMorpheEntity(config morpheEntityCompileConfig, r *registry.Registry, entity yaml.Entity) ([]tsdef.ObjectField, error) {
 	allFields := []tsdef.ObjectField{}
 
-	allRelatedEntityNames := core.MapKeysSorted(entityRelations)
+	allRelatedEntityNames := core.MapKeysSorted(entity.Related)
 	for _, relationshipName := range allRelatedEntityNames {
-		entityRelation := entityRelations[relationshipName]
+		entityRelation := entity.Related[relationshipName]
+		relationDocs := getMorpheRelationDocs(entityRelation.Type, relationshipName, entityRelation.Aliased, entityRelation.For, entityRelation.Through)
 
 		// Handle different relationship types
 		switch entityRelation.Type {
 		case "ForOnePoly", "ForManyPoly":
 			// For polymorphic "For" relationships, we need ID, type, and union fields
-			polyFields, polyErr := getPolymorphicForTsFieldsForEntity(r, relationshipName, entityRelation)
+			polyFields, polyErr := getPolymorphicForTsFieldsForEntity(config, r, relationshipName, entityRelation)
 			if polyErr != nil {
 				return nil, polyErr
 			}
-			allFields = append(allFields, polyFields...)
+			allFields = append(allFields, getObjectFieldsWithDocs(polyFields, relationDocs)...)
 
 		case "HasOnePoly", "HasManyPoly":
 			// For polymorphic "Has" relationships, use the aliased entity if provided, otherwise use relationship name
@@ -127,13 +256,23 @@ func getRelatedTsFieldsForMorpheEntity(r *registry.Registry, entityRelations map
 			}
 
 			// Generate regular ID and object fields with the relationship name
-			tsIDField, tsIDErr := getRelatedTsFieldForMorpheEntityPrimaryID(r, entityRelation.Type, relationshipName, targetEntityDef)
+			tsIDField, tsIDErr := getRelatedTsFieldForMorpheEntityPrimaryID(config, r, entityRelation.Type, relationshipName, targetEntityDef)
 			if tsIDErr != nil {
 				return nil, tsIDErr
 			}
+			tsIDField.Docs = relationDocs
 			allFields = append(allFields, tsIDField)
 
-			tsRelatedField := getRelatedTsFieldForMorpheEntityOptionalObjectWithTargetName(entityRelation.Type, relationshipName, targetEntityName)
+			tsRelatedField := getRelatedTsFieldForMorpheEntityOptionalObjectWithTargetName(entityRelation.Type, relationshipName, config.TypeNaming.GetTypeName(targetEntityName))
+			if entityRelation.Through != "" {
+				// Narrow the target to the records pointing back at this entity through the polymorphic "For" relationship
+				throughErr := validateMorpheEntityPolymorphicThrough(entity.Name, relationshipName, entityRelation.Through, targetEntityDef)
+				if throughErr != nil {
+					return nil, throughErr
+				}
+				tsRelatedField.Type = getPolymorphicThroughTsType(tsRelatedField.Type, entityRelation.Through, entity.Name)
+			}
+			tsRelatedField.Docs = relationDocs
 			allFields = append(allFields, tsRelatedField)
 
 		default:
@@ -148,33 +287,31 @@ func getRelatedTsFieldsForMorpheEntity(r *registry.Registry, entityRelations map
 				return nil, relatedEntityDefErr
 			}
 
-			tsIDField, tsIDErr := getRelatedTsFieldForMorpheEntityPrimaryID(r, entityRelation.Type, relationshipName, relatedEntityDef)
+			tsIDField, tsIDErr := getRelatedTsFieldForMorpheEntityPrimaryID(config, r, entityRelation.Type, relationshipName, relatedEntityDef)
 			if tsIDErr != nil {
 				return nil, tsIDErr
 			}
+			tsIDField.Docs = relationDocs
 			allFields = append(allFields, tsIDField)
 
-			tsRelatedField := getRelatedTsFieldForMorpheEntityOptionalObjectWithTargetName(entityRelation.Type, relationshipName, targetEntityName)
+			tsRelatedField := getRelatedTsFieldForMorpheEntityOptionalObjectWithTargetName(entityRelation.Type, relationshipName, config.TypeNaming.GetTypeName(targetEntityName))
+			tsRelatedField.Docs = relationDocs
 			allFields = append(allFields, tsRelatedField)
 		}
 	}
 	return allFields, nil
 }
 
-func getRelatedTsFieldForMorpheEntityPrimaryID(r *registry.Registry, relationType string, relatedEntityName string, relatedEntityDef yaml.Entity) (tsdef.ObjectField, error) {
+func getRelatedTsFieldForMorpheEntityPrimaryID(config morpheEntityCompileConfig, r *registry.Registry, relationType string, relatedEntityName string, relatedEntityDef yaml.Entity) (tsdef.ObjectField, error) {
 	relatedPrimaryIDFieldName, relatedIDFieldNameErr := yamlops.GetEntityPrimaryIdentifierFieldName(relatedEntityDef)
 	if relatedIDFieldNameErr != nil {
 		return tsdef.ObjectField{}, fmt.Errorf("related %w", relatedIDFieldNameErr)
 	}
 	idFieldName := strcase.ToCamelCase(fmt.Sprintf("%s%s", relatedEntityName, relatedPrimaryIDFieldName))
 
-	relatedPrimaryIDFieldDef, relatedIDFieldDefErr := yamlops.GetEntityFieldDefinitionByName(relatedEntityDef, relatedPrimaryIDFieldName)
-	if relatedIDFieldDefErr != nil {
-		return tsdef.ObjectField{}, fmt.Errorf("related %w (primary identifier)", relatedIDFieldDefErr)
-	}
-	idFieldType, typeErr := getTsTypeForEntityField(r, relatedPrimaryIDFieldDef)
+	idFieldType, typeErr := getRelatedMorpheEntityPrimaryIDTsType(config, r, relatedEntityDef, relatedPrimaryIDFieldName)
 	if typeErr != nil {
-		return tsdef.ObjectField{}, fmt.Errorf("related %w (primary identifier)", typeErr)
+		return tsdef.ObjectField{}, typeErr
 	}
 
 	if yamlops.IsRelationMany(relationType) {
@@ -198,6 +335,18 @@ func getRelatedTsFieldForMorpheEntityPrimaryID(r *registry.Registry, relationTyp
 	return tsIDField, nil
 }
 
+func getRelatedMorpheEntityPrimaryIDTsType(config morpheEntityCompileConfig, r *registry.Registry, relatedEntityDef yaml.Entity, relatedPrimaryIDFieldName string) (tsdef.TsType, error) {
+	relatedPrimaryIDFieldDef, relatedIDFieldDefErr := yamlops.GetEntityFieldDefinitionByName(relatedEntityDef, relatedPrimaryIDFieldName)
+	if relatedIDFieldDefErr != nil {
+		return nil, fmt.Errorf("related %w (primary identifier)", relatedIDFieldDefErr)
+	}
+	idFieldType, _, typeErr := getTsTypeForEntityField(config, r, relatedPrimaryIDFieldDef)
+	if typeErr != nil {
+		return nil, fmt.Errorf("related %w (primary identifier)", typeErr)
+	}
+	return idFieldType, nil
+}
+
 func getRelatedTsFieldForMorpheEntityOptionalObject(relationType string, relatedEntityName string) tsdef.ObjectField {
 	if yamlops.IsRelationMany(relationType) {
 		tsRelatedField := tsdef.ObjectField{
@@ -256,21 +405,23 @@ func getRelatedTsFieldForMorpheEntityOptionalObjectWithTargetName(relationType s
 	return tsRelatedField
 }
 
-func getPolymorphicForTsFieldsForEntity(r *registry.Registry, relationshipName string, entityRelation yaml.EntityRelation) ([]tsdef.ObjectField, error) {
-	if len(entityRelation.For) == 0 {
-		return nil, fmt.Errorf("polymorphic relation '%s' must have at least one entity in 'for' property", relationshipName)
+func getPolymorphicForTsFieldsForEntity(config morpheEntityCompileConfig, r *registry.Registry, relationshipName string, entityRelation yaml.EntityRelation) ([]tsdef.ObjectField, error) {
+	allTargets, targetsErr := getPolymorphicTargetsForMorpheEntity(config, r, relationshipName, entityRelation)
+	if targetsErr != nil {
+		return nil, targetsErr
 	}
 
 	relationshipNameCamel := strcase.ToCamelCase(relationshipName)
 	allFields := []tsdef.ObjectField{}
 
 	// Add ID field(s)
+	idType := getPolymorphicIDTsType(allTargets)
 	if yamlops.IsRelationMany(entityRelation.Type) {
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "IDs",
 			Type: tsdef.TsTypeOptional{
 				ValueType: tsdef.TsTypeArray{
-					ValueType: tsdef.TsTypeString,
+					ValueType: idType,
 				},
 			},
 		})
@@ -278,7 +429,7 @@ func getPolymorphicForTsFieldsForEntity(r *registry.Registry, relationshipName s
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "ID",
 			Type: tsdef.TsTypeOptional{
-				ValueType: tsdef.TsTypeString,
+				ValueType: idType,
 			},
 		})
 	}
@@ -287,27 +438,17 @@ func getPolymorphicForTsFieldsForEntity(r *registry.Registry, relationshipName s
 	allFields = append(allFields, tsdef.ObjectField{
 		Name: relationshipNameCamel + "Type",
 		Type: tsdef.TsTypeOptional{
-			ValueType: tsdef.TsTypeString,
+			ValueType: getPolymorphicTypeTsType(allTargets),
 		},
 	})
 
 	// Add union type field
-	unionTypes := []tsdef.TsType{}
-	for _, targetEntityName := range entityRelation.For {
-		unionTypes = append(unionTypes, tsdef.TsTypeObject{
-			ModulePath: "./" + strcase.ToKebabCaseLower(targetEntityName),
-			Name:       targetEntityName,
-		})
-	}
-
 	if yamlops.IsRelationMany(entityRelation.Type) {
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "s",
 			Type: tsdef.TsTypeOptional{
 				ValueType: tsdef.TsTypeArray{
-					ValueType: tsdef.TsTypeUnion{
-						Types: unionTypes,
-					},
+					ValueType: getPolymorphicValueTsType(allTargets),
 				},
 			},
 		})
@@ -315,12 +456,80 @@ func getPolymorphicForTsFieldsForEntity(r *registry.Registry, relationshipName s
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel,
 			Type: tsdef.TsTypeOptional{
-				ValueType: tsdef.TsTypeUnion{
-					Types: unionTypes,
-				},
+				ValueType: getPolymorphicValueTsType(allTargets),
 			},
 		})
 	}
 
 	return allFields, nil
 }
+
+func getPolymorphicTargetsForMorpheEntity(config morpheEntityCompileConfig, r *registry.Registry, relationshipName string, entityRelation yaml.EntityRelation) ([]polymorphicTarget, error) {
+	if len(entityRelation.For) == 0 {
+		return nil, fmt.Errorf("polymorphic relation '%s' must have at least one entity in 'for' property", relationshipName)
+	}
+
+	allTargets := []polymorphicTarget{}
+	for _, targetEntityName := range entityRelation.For {
+		targetEntityDef, targetEntityDefErr := r.GetEntity(targetEntityName)
+		if targetEntityDefErr != nil {
+			return nil, targetEntityDefErr
+		}
+		targetPrimaryIDFieldName, targetIDFieldNameErr := yamlops.GetEntityPrimaryIdentifierFieldName(targetEntityDef)
+		if targetIDFieldNameErr != nil {
+			return nil, fmt.Errorf("related %w", targetIDFieldNameErr)
+		}
+		targetIDType, targetIDTypeErr := getRelatedMorpheEntityPrimaryIDTsType(config, r, targetEntityDef, targetPrimaryIDFieldName)
+		if targetIDTypeErr != nil {
+			return nil, targetIDTypeErr
+		}
+		allTargets = append(allTargets, polymorphicTarget{
+			name:     targetEntityName,
+			typeName: config.TypeNaming.GetTypeName(targetEntityName),
+			idType:   targetIDType,
+		})
+	}
+	return allTargets, nil
+}
+
+// validateMorpheEntityPolymorphicThrough ensures the target entity of a polymorphic "Has" relationship points back at
+// the entity through a polymorphic "For" relationship listing it.
+//
+// Morphe only checks this for aliased relationships, so unaliased ones are checked here with the same error.
+func validateMorpheEntityPolymorphicThrough(entityName string, relationshipName string, through string, targetEntityDef yaml.Entity) error {
+	throughRelation, hasThroughRelation := targetEntityDef.Related[through]
+	if !hasThroughRelation {
+		return yaml.ErrMorpheEntityPolymorphicInverseValidation(entityName, relationshipName, targetEntityDef.Name, through,
+			fmt.Sprintf("aliased entity '%s' does not have relationship '%s'", targetEntityDef.Name, through))
+	}
+	if !yamlops.IsRelationPolyFor(throughRelation.Type) {
+		return yaml.ErrMorpheEntityPolymorphicInverseValidation(entityName, relationshipName, targetEntityDef.Name, through,
+			fmt.Sprintf("relationship '%s' in entity '%s' is not a polymorphic 'For' relationship (type: %s)", through, targetEntityDef.Name, throughRelation.Type))
+	}
+	if !slices.Contains(throughRelation.For, entityName) {
+		return yaml.ErrMorpheEntityPolymorphicInverseValidation(entityName, relationshipName, targetEntityDef.Name, through,
+			fmt.Sprintf("entity '%s' is not in the 'for' list of polymorphic relationship '%s' in entity '%s'", entityName, through, targetEntityDef.Name))
+	}
+	return nil
+}
+
+// getAllEntityPolymorphicUnionObjectTypes returns the discriminated union types of all polymorphic for-relations of an entity.
+func getAllEntityPolymorphicUnionObjectTypes(config morpheEntityCompileConfig, r *registry.Registry, entity yaml.Entity, entityType *tsdef.Object) ([]*tsdef.Object, error) {
+	if !config.DiscriminatedUnions {
+		return nil, nil
+	}
+
+	allUnionObjectTypes := []*tsdef.Object{}
+	for _, relationshipName := range core.MapKeysSorted(entity.Related) {
+		entityRelation := entity.Related[relationshipName]
+		if !yamlops.IsRelationPolyFor(entityRelation.Type) {
+			continue
+		}
+		allTargets, targetsErr := getPolymorphicTargetsForMorpheEntity(config, r, relationshipName, entityRelation)
+		if targetsErr != nil {
+			return nil, targetsErr
+		}
+		allUnionObjectTypes = append(allUnionObjectTypes, getPolymorphicUnionObjectTypes(entityType.Name, relationshipName, allTargets)...)
+	}
+	return allUnionObjectTypes, nil
+}
@@ -0,0 +1,81 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/morphe-go/pkg/yamlfile"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// morpheEnumEntryOrder reads an enum file with its entries as a YAML node, which keeps the declaration order the
+// loaded `yaml.Enum` entries map loses.
+type morpheEnumEntryOrder struct {
+	Name    string      `yaml:"name"`
+	Entries yamlv3.Node `yaml:"entries"`
+}
+
+// getDeclaredEnumEntryNames loads the entry names of all enums in the registry enums directory in declaration order.
+func getDeclaredEnumEntryNames(enumsDirPath string) (map[string][]string, error) {
+	allEnumEntryOrders, loadErr := yamlfile.UnmarshalAllYAMLFiles[morpheEnumEntryOrder](enumsDirPath, registry.EnumFileSuffix)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	allDeclaredEntryNames := map[string][]string{}
+	for _, enumFilePath := range core.MapKeysSorted(allEnumEntryOrders) {
+		enumEntryOrder := allEnumEntryOrders[enumFilePath]
+		if enumEntryOrder.Entries.Kind != yamlv3.MappingNode {
+			continue
+		}
+		entryNames := []string{}
+		for keyIdx := 0; keyIdx < len(enumEntryOrder.Entries.Content); keyIdx += 2 {
+			entryNames = append(entryNames, enumEntryOrder.Entries.Content[keyIdx].Value)
+		}
+		allDeclaredEntryNames[enumEntryOrder.Name] = entryNames
+	}
+	return allDeclaredEntryNames, nil
+}
+
+// getMorpheEnumConfigWithEntryOrder loads the declared entry names from the registry enums directory if declared entry
+// order is enabled and no declared entry names were provided.
+func getMorpheEnumConfigWithEntryOrder(config cfg.MorpheEnumsConfig, enumsDirPath string) (cfg.MorpheEnumsConfig, error) {
+	if config.EntryOrder != cfg.EnumEntryOrderDeclared || config.DeclaredEntryNames != nil || enumsDirPath == "" {
+		return config, nil
+	}
+	allDeclaredEntryNames, loadErr := getDeclaredEnumEntryNames(enumsDirPath)
+	if loadErr != nil {
+		return config, loadErr
+	}
+	config.DeclaredEntryNames = allDeclaredEntryNames
+	return config, nil
+}
+
+// getMorpheEnumEntryNames returns the entry names of an enum in the configured order.
+//
+// With declared entry order, entries missing from the declared entry names (e.g. added by a hook) follow the declared
+// entries sorted by name.
+func getMorpheEnumEntryNames(config cfg.MorpheEnumsConfig, enum yaml.Enum) []string {
+	sortedEntryNames := core.MapKeysSorted(enum.Entries)
+	if config.EntryOrder != cfg.EnumEntryOrderDeclared {
+		return sortedEntryNames
+	}
+
+	entryNames := []string{}
+	isDeclared := map[string]bool{}
+	for _, entryName := range config.DeclaredEntryNames[enum.Name] {
+		_, entryExists := enum.Entries[entryName]
+		if !entryExists || isDeclared[entryName] {
+			continue
+		}
+		entryNames = append(entryNames, entryName)
+		isDeclared[entryName] = true
+	}
+	for _, entryName := range sortedEntryNames {
+		if !isDeclared[entryName] {
+			entryNames = append(entryNames, entryName)
+		}
+	}
+	return entryNames
+}
@@ -3,6 +3,7 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 )
@@ -17,3 +18,19 @@ func ErrUnsupportedEnumType(morpheType yaml.EnumType) error {
 func ErrEnumEntryNotFound(entryName string) error {
 	return fmt.Errorf("morphe enum entry '%s' not found", entryName)
 }
+
+func ErrInvalidEnumEntryValue(enumName string, entryName string, valueErr error) error {
+	return fmt.Errorf("invalid value of morphe enum entry '%s.%s': %w", enumName, entryName, valueErr)
+}
+
+func ErrInvalidTsEnumName(enumName string) error {
+	return fmt.Errorf("morphe enum '%s' name is not a valid typescript identifier", enumName)
+}
+
+func ErrInvalidTsEnumEntryName(enumName string, entryName string, tsEntryName string) error {
+	return fmt.Errorf("morphe enum '%s' entry '%s' compiles to entry name '%s', which is not a valid typescript enum member name", enumName, entryName, tsEntryName)
+}
+
+func ErrTsEnumEntryNameCollision(enumName string, allEntryNames []string, tsEntryName string) error {
+	return fmt.Errorf("morphe enum '%s' entries '%s' all compile to entry name '%s'", enumName, strings.Join(allEntryNames, "', '"), tsEntryName)
+}
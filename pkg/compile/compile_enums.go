@@ -1,7 +1,6 @@
 package compile
 
 import (
-	"github.com/kalo-build/go-util/core"
 	"github.com/kalo-build/morphe-go/pkg/registry"
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
@@ -10,9 +9,19 @@ import (
 )
 
 func AllMorpheEnumsToTsEnums(config MorpheCompileConfig, r *registry.Registry) (map[string]*tsdef.Enum, error) {
+	enumsConfig, entryOrderErr := getMorpheEnumConfigWithEntryOrder(config.MorpheEnumsConfig, config.RegistryEnumsDirPath)
+	if entryOrderErr != nil {
+		return nil, entryOrderErr
+	}
+	allSourceFileNames, sourceFileNamesErr := getMorpheSourceFileNamesOrLoad(enumsConfig.SourceFileNames, config.RegistryEnumsDirPath, registry.EnumFileSuffix)
+	if sourceFileNamesErr != nil {
+		return nil, sourceFileNamesErr
+	}
+	enumsConfig.SourceFileNames = allSourceFileNames
+
 	allEnumTypeDefs := map[string]*tsdef.Enum{}
 	for enumName, enum := range r.GetAllEnums() {
-		enumType, enumErr := MorpheEnumToTsEnum(config.EnumHooks, config.MorpheEnumsConfig, enum)
+		enumType, enumErr := MorpheEnumToTsEnum(config.EnumHooks, enumsConfig, enum)
 		if enumErr != nil {
 			return nil, enumErr
 		}
@@ -48,11 +57,16 @@ func morpheEnumToTsEnumType(config cfg.MorpheEnumsConfig, enum yaml.Enum) (*tsde
 		return nil, validateMorpheErr
 	}
 
-	enumType, enumTypeErr := getTypescriptEnum(enum)
+	enumType, enumTypeErr := getTypescriptEnum(config, enum)
 	if enumTypeErr != nil {
 		return nil, enumTypeErr
 	}
 
+	identifiersErr := validateTsEnumIdentifiers(config.IdentifierHandling, enumType)
+	if identifiersErr != nil {
+		return nil, identifiersErr
+	}
+
 	return enumType, nil
 }
 
@@ -93,9 +107,10 @@ func triggerCompileMorpheEnumFailure(hooks hook.CompileMorpheEnum, config cfg.Mo
 	return hooks.OnCompileMorpheEnumFailure(config, enum.DeepClone(), failureErr)
 }
 
-func getTypescriptEnum(enum yaml.Enum) (*tsdef.Enum, error) {
+func getTypescriptEnum(config cfg.MorpheEnumsConfig, enum yaml.Enum) (*tsdef.Enum, error) {
 	enumType := tsdef.Enum{
 		Name: enum.Name,
+		Docs: getMorpheEnumSourceDocs(config, enum.Name),
 	}
 	tsEnumType, tsEnumTypeErr := morpheEnumTypeToTsEnumType(enum.Type)
 	if tsEnumTypeErr != nil {
@@ -103,7 +118,7 @@ func getTypescriptEnum(enum yaml.Enum) (*tsdef.Enum, error) {
 	}
 	enumType.Type = tsEnumType
 
-	tsEntries, entriesErr := getTsEntriesForMorpheEnum(enum.Entries)
+	tsEntries, entriesErr := getTsEntriesForMorpheEnum(enum.Name, enum.Entries, getMorpheEnumEntryNames(config, enum))
 	if entriesErr != nil {
 		return nil, entriesErr
 	}
@@ -124,15 +139,18 @@ func morpheEnumTypeToTsEnumType(morpheType yaml.EnumType) (tsdef.TsType, error)
 	}
 }
 
-func getTsEntriesForMorpheEnum(entries map[string]any) ([]tsdef.EnumEntry, error) {
+func getTsEntriesForMorpheEnum(enumName string, entries map[string]any, entryNames []string) ([]tsdef.EnumEntry, error) {
 	tsEntries := []tsdef.EnumEntry{}
-	entryNames := core.MapKeysSorted(entries)
 
 	for _, entryName := range entryNames {
 		entryValue, entryExists := entries[entryName]
 		if !entryExists {
 			return nil, ErrEnumEntryNotFound(entryName)
 		}
+		_, literalErr := getEnumValueLiteral(entryValue)
+		if literalErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumName, entryName, literalErr)
+		}
 		tsEntries = append(tsEntries, tsdef.EnumEntry{
 			Name:  entryName,
 			Value: entryValue,
@@ -2,6 +2,7 @@ package compile_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/kalo-build/morphe-go/pkg/yaml"
@@ -47,6 +48,7 @@ func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_String() {
 
 	suite.Equal(tsEnum.Name, "Color")
 	suite.Equal(tsEnum.Type, tsdef.TsTypeString)
+	suite.Equal(tsEnum.Docs, []string{"Morphe enum `Color`"})
 
 	tsEntries0 := tsEnum.Entries
 	suite.Len(tsEntries0, 3)
@@ -130,6 +132,224 @@ func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_Integer() {
 	suite.Equal(tsEntry01.Value, 317)
 }
 
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_DeclaredEntryOrder() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{
+		EntryOrder: cfg.EnumEntryOrderDeclared,
+		DeclaredEntryNames: map[string][]string{
+			"Priority": {"Low", "Medium", "High"},
+		},
+	}
+
+	enum0 := yaml.Enum{
+		Name: "Priority",
+		Type: yaml.EnumTypeInteger,
+		Entries: map[string]any{
+			"Low":      1,
+			"Medium":   2,
+			"High":     3,
+			"Critical": 4,
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.Nil(tsEnumErr)
+
+	suite.Equal(tsEnum.Name, "Priority")
+
+	tsEntries0 := tsEnum.Entries
+	suite.Len(tsEntries0, 4)
+
+	tsEntry00 := tsEntries0[0]
+	suite.Equal(tsEntry00.Name, "Low")
+	suite.Equal(tsEntry00.Value, 1)
+
+	tsEntry01 := tsEntries0[1]
+	suite.Equal(tsEntry01.Name, "Medium")
+	suite.Equal(tsEntry01.Value, 2)
+
+	tsEntry02 := tsEntries0[2]
+	suite.Equal(tsEntry02.Name, "High")
+	suite.Equal(tsEntry02.Value, 3)
+
+	tsEntry03 := tsEntries0[3]
+	suite.Equal(tsEntry03.Name, "Critical")
+	suite.Equal(tsEntry03.Value, 4)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_UnsupportedEntryOrder() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{
+		EntryOrder: "reversed",
+	}
+
+	enum0 := yaml.Enum{
+		Name: "Priority",
+		Type: yaml.EnumTypeInteger,
+		Entries: map[string]any{
+			"Low": 1,
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "unsupported enum entry order 'reversed'")
+	suite.Nil(tsEnum)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_NaN() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{}
+
+	enum0 := yaml.Enum{
+		Name: "Analytics",
+		Type: yaml.EnumTypeFloat,
+		Entries: map[string]any{
+			"Pi":      3.141,
+			"Unknown": math.NaN(),
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "invalid value of morphe enum entry 'Analytics.Unknown': typescript number literal must be finite, got 'NaN'")
+	suite.Nil(tsEnum)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_InvalidEntryName() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{}
+
+	enum0 := yaml.Enum{
+		Name: "AuthMethod",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"Password": "password",
+			"2FA":      "two-factor",
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "morphe enum 'AuthMethod' entry '2FA' compiles to entry name '2FA', which is not a valid typescript enum member name")
+	suite.Nil(tsEnum)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_InvalidEntryName_Quote() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingQuote,
+	}
+
+	enum0 := yaml.Enum{
+		Name: "AuthMethod",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"Password": "password",
+			"2FA":      "two-factor",
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.Nil(tsEnumErr)
+	suite.Len(tsEnum.Entries, 2)
+	suite.Equal(tsEnum.Entries[0].Name, "2FA")
+	suite.Equal(tsEnum.Entries[1].Name, "Password")
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_InvalidEntryName_QuoteNumeric() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingQuote,
+	}
+
+	enum0 := yaml.Enum{
+		Name: "Level",
+		Type: yaml.EnumTypeInteger,
+		Entries: map[string]any{
+			"1": 1,
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "morphe enum 'Level' entry '1' compiles to entry name '1', which is not a valid typescript enum member name")
+	suite.Nil(tsEnum)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_InvalidEntryName_Rename() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingRename,
+	}
+
+	enum0 := yaml.Enum{
+		Name: "AuthMethod",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"Password": "password",
+			"2FA":      "two-factor",
+			"NaN":      "not-a-number",
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.Nil(tsEnumErr)
+	suite.Len(tsEnum.Entries, 3)
+	suite.Equal(tsEnum.Entries[0].Name, "$2FA")
+	suite.Equal(tsEnum.Entries[1].Name, "$NaN")
+	suite.Equal(tsEnum.Entries[2].Name, "Password")
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_EntryNameCollision() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{}
+
+	enum0 := yaml.Enum{
+		Name: "Status",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"InProgress":  "in-progress",
+			"in_progress": "in_progress",
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "morphe enum 'Status' entries 'InProgress', 'in_progress' all compile to entry name 'InProgress'")
+	suite.Nil(tsEnum)
+}
+
+func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_ReservedName() {
+	enumHooks := hook.CompileMorpheEnum{}
+
+	enumsConfig := cfg.MorpheEnumsConfig{}
+
+	enum0 := yaml.Enum{
+		Name: "string",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"Short": "short",
+		},
+	}
+
+	tsEnum, tsEnumErr := compile.MorpheEnumToTsEnum(enumHooks, enumsConfig, enum0)
+
+	suite.ErrorContains(tsEnumErr, "morphe enum 'string' name is not a valid typescript identifier")
+	suite.Nil(tsEnum)
+}
+
 func (suite *CompileEnumsTestSuite) TestMorpheEnumToTsObjects_NoName() {
 	enumHooks := hook.CompileMorpheEnum{}
 
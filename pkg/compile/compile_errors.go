@@ -3,6 +3,7 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 )
@@ -11,6 +12,8 @@ var ErrNoRegistry = errors.New("registry not initialized")
 var ErrNoMorpheModelName = errors.New("morphe model has no name")
 var ErrNoMorpheModelFields = errors.New("morphe model has no fields")
 var ErrNoMorpheModelIdentifiers = errors.New("morphe model has no identifiers")
+var ErrNoIndex = errors.New("no index provided")
+var ErrNoBundle = errors.New("no bundle provided")
 
 func ErrUnsupportedMorpheFieldType[TType yaml.ModelFieldType | yaml.StructureFieldType | yaml.ModelFieldPath](unsupportedType TType) error {
 	return fmt.Errorf("unsupported morphe field type for typescript conversion: '%s'", unsupportedType)
@@ -19,3 +22,30 @@ func ErrUnsupportedMorpheFieldType[TType yaml.ModelFieldType | yaml.StructureFie
 func ErrMissingMorpheIdentifierField(modelName string, identifierName string, fieldName string) error {
 	return fmt.Errorf("morphe model '%s' has no field '%s' referenced in identifiers ('%s')", modelName, identifierName, fieldName)
 }
+
+func ErrBundleNameCollision(typeName string, fileKey string, otherFileKey string) error {
+	return fmt.Errorf("bundled type name '%s' of '%s' collides with '%s'", typeName, fileKey, otherFileKey)
+}
+
+func ErrInvalidTsTypeName(morpheKind string, morpheName string, typeName string) error {
+	return fmt.Errorf("morphe %s '%s' compiles to type name '%s', which is not a valid typescript identifier", morpheKind, morpheName, typeName)
+}
+
+func ErrInvalidTsFieldName(morpheKind string, morpheName string, morpheFieldName string, typeName string, fieldName string) error {
+	return fmt.Errorf("morphe %s '%s' field '%s' compiles to field name '%s' of '%s', which is not a valid typescript identifier name", morpheKind, morpheName, morpheFieldName, fieldName, typeName)
+}
+
+func ErrTsFieldNameCollision(morpheKind string, morpheName string, allMorpheFieldNames []string, typeName string, fieldName string) error {
+	if len(allMorpheFieldNames) < 2 {
+		return fmt.Errorf("morphe %s '%s' compiles to multiple fields named '%s' of '%s'", morpheKind, morpheName, fieldName, typeName)
+	}
+	return fmt.Errorf("morphe %s '%s' fields '%s' all compile to field name '%s' of '%s'", morpheKind, morpheName, strings.Join(allMorpheFieldNames, "', '"), fieldName, typeName)
+}
+
+func ErrNoWireFormatDiscriminator(unionSyntax string) error {
+	return fmt.Errorf("no discriminator to convert union '%s' from and to its wire format", unionSyntax)
+}
+
+func ErrOutputLayoutWriterDirName(kind string, writerDirName string, layoutDirName string) error {
+	return fmt.Errorf("%s writer writes to directory '%s', but the output layout names it '%s'", kind, writerDirName, layoutDirName)
+}
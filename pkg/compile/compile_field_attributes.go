@@ -0,0 +1,51 @@
+package compile
+
+import (
+	"slices"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const (
+	morpheFieldAttributeMandatory = "mandatory"
+	morpheFieldAttributeImmutable = "immutable"
+	morpheFieldAttributeList      = "list"
+)
+
+func hasMorpheFieldAttribute(allAttributes []string, attribute string) bool {
+	return slices.Contains(allAttributes, attribute)
+}
+
+// getTsTypeWithFieldOptionality wraps the field type according to the configured optionality, unless the field is mandatory.
+func getTsTypeWithFieldOptionality(optionality cfg.FieldOptionality, allAttributes []string, tsType tsdef.TsType) tsdef.TsType {
+	if hasMorpheFieldAttribute(allAttributes, morpheFieldAttributeMandatory) {
+		return tsType
+	}
+
+	switch optionality {
+	case cfg.FieldOptionalityOptional:
+		return tsdef.TsTypeOptional{
+			ValueType: tsType,
+		}
+	case cfg.FieldOptionalityNullable:
+		return tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsType,
+				tsdef.TsTypeNull,
+			},
+		}
+	default:
+		return tsType
+	}
+}
+
+// getTsTypeWithFieldList wraps the field type in an array if the field is a list.
+func getTsTypeWithFieldList(allAttributes []string, tsType tsdef.TsType) tsdef.TsType {
+	if !hasMorpheFieldAttribute(allAttributes, morpheFieldAttributeList) {
+		return tsType
+	}
+	return tsdef.TsTypeArray{
+		ValueType: tsType,
+	}
+}
@@ -0,0 +1,151 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// morpheIdentifierSource is the Morphe definition compiled types are validated for, used to name the source of
+// invalid identifiers in errors.
+type morpheIdentifierSource struct {
+	kind string
+	name string
+	// allFieldNames are the names of all fields and relations of the definition
+	allFieldNames []string
+}
+
+func getMorpheModelIdentifierSource(model yaml.Model) morpheIdentifierSource {
+	return morpheIdentifierSource{
+		kind:          "model",
+		name:          model.Name,
+		allFieldNames: append(core.MapKeysSorted(model.Fields), core.MapKeysSorted(model.Related)...),
+	}
+}
+
+func getMorpheStructureIdentifierSource(structure yaml.Structure) morpheIdentifierSource {
+	return morpheIdentifierSource{
+		kind:          "structure",
+		name:          structure.Name,
+		allFieldNames: core.MapKeysSorted(structure.Fields),
+	}
+}
+
+func getMorpheEntityIdentifierSource(entity yaml.Entity) morpheIdentifierSource {
+	return morpheIdentifierSource{
+		kind:          "entity",
+		name:          entity.Name,
+		allFieldNames: append(core.MapKeysSorted(entity.Fields), core.MapKeysSorted(entity.Related)...),
+	}
+}
+
+// getTsFieldName is the name object fields are written with.
+func getTsFieldName(fieldName string) string {
+	return strcase.ToCamelCase(fieldName)
+}
+
+// getTsEnumEntryName is the name enum entries are written with.
+func getTsEnumEntryName(entryName string) string {
+	return strcase.ToPascalCase(entryName)
+}
+
+// validateTsObjectIdentifiers checks that all type names are valid identifiers and that all field names are written as
+// distinct, valid property names, quoting or renaming invalid field names as configured.
+//
+// Fields are validated by the name they are written with, so `FirstName` and `first_name` collide as `firstName`.
+// Renamed names are cased like written names, so writers keep them as is. Renaming is deterministic, so fields derived
+// from the same Morphe field are renamed alike across all types.
+func validateTsObjectIdentifiers(identifierHandling cfg.IdentifierHandling, source morpheIdentifierSource, allObjectTypes []*tsdef.Object) error {
+	for _, objectType := range allObjectTypes {
+		if !tsdef.IsTsIdentifier(objectType.Name) {
+			return ErrInvalidTsTypeName(source.kind, source.name, objectType.Name)
+		}
+
+		allWrittenFieldNames := map[string]bool{}
+		for fieldIdx, objectField := range objectType.Fields {
+			fieldName := getTsFieldName(objectField.Name)
+			if !tsdef.IsTsIdentifierName(fieldName) {
+				switch identifierHandling {
+				case cfg.IdentifierHandlingQuote:
+				case cfg.IdentifierHandlingRename:
+					renamedFieldName := getTsFieldName(tsdef.GetTsSanitizedIdentifierName(fieldName))
+					if !tsdef.IsTsIdentifierName(renamedFieldName) || getTsFieldName(renamedFieldName) != renamedFieldName {
+						return ErrInvalidTsFieldName(source.kind, source.name, source.getFieldName(fieldName), objectType.Name, fieldName)
+					}
+					objectType.Fields[fieldIdx].Name = renamedFieldName
+					fieldName = renamedFieldName
+				default:
+					return ErrInvalidTsFieldName(source.kind, source.name, source.getFieldName(fieldName), objectType.Name, fieldName)
+				}
+			}
+			if allWrittenFieldNames[fieldName] {
+				return ErrTsFieldNameCollision(source.kind, source.name, source.getAllFieldNames(objectField.Name), objectType.Name, fieldName)
+			}
+			allWrittenFieldNames[fieldName] = true
+		}
+	}
+	return nil
+}
+
+// validateTsEnumIdentifiers checks that the enum name is a valid identifier and that all entry names are written as
+// distinct, valid enum member names, quoting or renaming invalid entry names as configured.
+func validateTsEnumIdentifiers(identifierHandling cfg.IdentifierHandling, enumType *tsdef.Enum) error {
+	if !tsdef.IsTsIdentifier(enumType.Name) {
+		return ErrInvalidTsEnumName(enumType.Name)
+	}
+
+	allWrittenEntryNames := map[string]string{}
+	for entryIdx, enumEntry := range enumType.Entries {
+		entryName := getTsEnumEntryName(enumEntry.Name)
+		if !isTsEnumMemberNameUnquoted(entryName) {
+			switch {
+			case identifierHandling == cfg.IdentifierHandlingQuote && tsdef.IsTsEnumMemberName(entryName):
+			case identifierHandling == cfg.IdentifierHandlingRename:
+				renamedEntryName := tsdef.GetTsSanitizedIdentifierName(entryName)
+				if !isTsEnumMemberNameUnquoted(renamedEntryName) {
+					renamedEntryName = "$" + renamedEntryName
+				}
+				renamedEntryName = getTsEnumEntryName(renamedEntryName)
+				if !isTsEnumMemberNameUnquoted(renamedEntryName) || getTsEnumEntryName(renamedEntryName) != renamedEntryName {
+					return ErrInvalidTsEnumEntryName(enumType.Name, enumEntry.Name, entryName)
+				}
+				enumType.Entries[entryIdx].Name = renamedEntryName
+				entryName = renamedEntryName
+			default:
+				return ErrInvalidTsEnumEntryName(enumType.Name, enumEntry.Name, entryName)
+			}
+		}
+		if otherEntryName, entryExists := allWrittenEntryNames[entryName]; entryExists {
+			return ErrTsEnumEntryNameCollision(enumType.Name, []string{otherEntryName, enumEntry.Name}, entryName)
+		}
+		allWrittenEntryNames[entryName] = enumEntry.Name
+	}
+	return nil
+}
+
+func isTsEnumMemberNameUnquoted(entryName string) bool {
+	return tsdef.IsTsIdentifierName(entryName) && tsdef.IsTsEnumMemberName(entryName)
+}
+
+// getFieldName returns the Morphe field or relation the field name was written for, or the field name itself if it
+// was generated, e.g. a relation ID field.
+func (source morpheIdentifierSource) getFieldName(tsFieldName string) string {
+	allFieldNames := source.getAllFieldNames(tsFieldName)
+	if len(allFieldNames) == 0 {
+		return tsFieldName
+	}
+	return allFieldNames[0]
+}
+
+// getAllFieldNames returns all Morphe fields and relations which are written as the field name.
+func (source morpheIdentifierSource) getAllFieldNames(tsFieldName string) []string {
+	allFieldNames := []string{}
+	for _, fieldName := range source.allFieldNames {
+		if getTsFieldName(fieldName) == getTsFieldName(tsFieldName) {
+			allFieldNames = append(allFieldNames, fieldName)
+		}
+	}
+	return allFieldNames
+}
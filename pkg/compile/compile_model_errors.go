@@ -1,6 +1,13 @@
 package compile
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var ErrNoModelObjects = errors.New("no model objects provided")
 var ErrNoModelObject = errors.New("no model object provided")
+
+func ErrMissingMorpheModelField(modelTypeName string, fieldName string) error {
+	return fmt.Errorf("model type '%s' has no field for morphe field '%s'", modelTypeName, fieldName)
+}
@@ -9,19 +9,18 @@ import (
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 	"github.com/kalo-build/morphe-go/pkg/yamlops"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
-	"github.com/kalo-build/plugin-morphe-ts-types/pkg/typemap"
 )
 
-func getTsFieldsForMorpheModel(r *registry.Registry, modelFields map[string]yaml.ModelField, modelRelations map[string]yaml.ModelRelation) ([]tsdef.ObjectField, error) {
+func getTsFieldsForMorpheModel(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) ([]tsdef.ObjectField, error) {
 	if r == nil {
 		return nil, ErrNoRegistry
 	}
-	allFields, fieldErr := getDirectTsFieldsForMorpheModel(r.GetAllEnums(), modelFields)
+	allFields, fieldErr := getDirectTsFieldsForMorpheModel(config, r, model)
 	if fieldErr != nil {
 		return nil, fieldErr
 	}
 
-	allRelatedFields, relatedErr := getRelatedTsFieldsForMorpheModel(r, modelRelations)
+	allRelatedFields, relatedErr := getRelatedTsFieldsForMorpheModel(config, r, model)
 	if relatedErr != nil {
 		return nil, relatedErr
 	}
@@ -30,25 +29,52 @@ func getTsFieldsForMorpheModel(r *registry.Registry, modelFields map[string]yaml
 	return allFields, nil
 }
 
-func getDirectTsFieldsForMorpheModel(allEnums map[string]yaml.Enum, modelFields map[string]yaml.ModelField) ([]tsdef.ObjectField, error) {
+func getDirectTsFieldsForMorpheModel(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) ([]tsdef.ObjectField, error) {
+	brandedIDFieldName := ""
+	if config.BrandedIDs {
+		brandedIDFieldName = getModelBrandedIDFieldName(model)
+	}
+
+	allEnums := r.GetAllEnums()
+	allStructures := r.GetAllStructures()
 	allFields := []tsdef.ObjectField{}
-	allFieldNames := core.MapKeysSorted(modelFields)
+	allFieldNames := core.MapKeysSorted(model.Fields)
 	for _, fieldName := range allFieldNames {
-		fieldDef := modelFields[fieldName]
+		fieldDef := model.Fields[fieldName]
 
-		tsEnumField := getEnumFieldAsTsFieldType(allEnums, fieldName, string(fieldDef.Type))
+		tsEnumField := getEnumFieldAsTsFieldType(config.OutputLayout.GetEnumsDirName(), allEnums, fieldName, string(fieldDef.Type))
 		if tsEnumField.Name != "" && tsEnumField.Type != nil {
+			tsEnumField.Type = getTsTypeWithFieldOptionality(config.FieldOptionality, fieldDef.Attributes, tsEnumField.Type)
+			tsEnumField.Readonly = hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeImmutable)
+			tsEnumField.Docs = getMorpheFieldDocs(fieldDef.Attributes)
 			allFields = append(allFields, tsEnumField)
 			continue
 		}
 
-		tsFieldType, typeSupported := typemap.MorpheModelFieldToTsField[fieldDef.Type]
-		if !typeSupported {
-			return nil, ErrUnsupportedMorpheFieldType(fieldDef.Type)
+		tsStructureType, isStructure := getStructureAsTsType("../"+config.OutputLayout.GetStructuresDirName(), allStructures, string(fieldDef.Type))
+		if isStructure {
+			allFields = append(allFields, tsdef.ObjectField{
+				Name:     strcase.ToCamelCase(fieldName),
+				Type:     getTsTypeWithFieldOptionality(config.FieldOptionality, fieldDef.Attributes, tsStructureType),
+				Readonly: hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeImmutable),
+				Docs:     getMorpheFieldDocs(fieldDef.Attributes),
+			})
+			continue
+		}
+
+		tsFieldType, typeErr := getTsTypeForMorpheModelFieldType(config.TypeMappings, fieldDef.Type)
+		if typeErr != nil {
+			return nil, typeErr
+		}
+		if fieldName == brandedIDFieldName {
+			tsFieldType = getModelBrandedIDType("", config.TypeNaming.GetTypeName(model.Name))
 		}
 		tsField := tsdef.ObjectField{
-			Name: strcase.ToCamelCase(fieldName),
-			Type: tsFieldType,
+			Name:     strcase.ToCamelCase(fieldName),
+			Type:     getTsTypeWithFieldOptionality(config.FieldOptionality, fieldDef.Attributes, tsFieldType),
+			Readonly: hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeImmutable),
+			Docs:     getMorpheFieldDocs(fieldDef.Attributes),
+			DateOnly: fieldDef.Type == yaml.ModelFieldTypeDate,
 		}
 		allFields = append(allFields, tsField)
 	}
@@ -56,22 +82,47 @@ func getDirectTsFieldsForMorpheModel(allEnums map[string]yaml.Enum, modelFields
 	return allFields, nil
 }
 
-func getRelatedTsFieldsForMorpheModel(r *registry.Registry, modelRelations map[string]yaml.ModelRelation) ([]tsdef.ObjectField, error) {
+// relatedTsFields are the fields generated for a single model relation
+type relatedTsFields struct {
+	allIDFields []tsdef.ObjectField
+	objectField tsdef.ObjectField
+}
+
+func getRelatedTsFieldsForMorpheModel(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) ([]tsdef.ObjectField, error) {
+	allRelatedFields, relatedErr := getAllRelatedTsFieldsForMorpheModel(config, r, model)
+	if relatedErr != nil {
+		return nil, relatedErr
+	}
+
 	allFields := []tsdef.ObjectField{}
+	for _, relatedFields := range allRelatedFields {
+		allFields = append(allFields, relatedFields.allIDFields...)
+		allFields = append(allFields, relatedFields.objectField)
+	}
+	return allFields, nil
+}
 
-	allRelatedModelNames := core.MapKeysSorted(modelRelations)
+func getAllRelatedTsFieldsForMorpheModel(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) ([]relatedTsFields, error) {
+	allRelatedFields := []relatedTsFields{}
+
+	allRelatedModelNames := core.MapKeysSorted(model.Related)
 	for _, relationshipName := range allRelatedModelNames {
-		modelRelation := modelRelations[relationshipName]
+		modelRelation := model.Related[relationshipName]
+		relationDocs := getMorpheRelationDocs(modelRelation.Type, relationshipName, modelRelation.Aliased, modelRelation.For, modelRelation.Through)
 
 		// Handle different relationship types
 		switch modelRelation.Type {
 		case "ForOnePoly", "ForManyPoly":
 			// For polymorphic "For" relationships, we need ID, type, and union fields
-			polyFields, polyErr := getPolymorphicForTsFields(r, relationshipName, modelRelation)
+			polyFields, polyErr := getPolymorphicForTsFields(config, r, relationshipName, modelRelation)
 			if polyErr != nil {
 				return nil, polyErr
 			}
-			allFields = append(allFields, polyFields...)
+			polyFields = getObjectFieldsWithDocs(polyFields, relationDocs)
+			allRelatedFields = append(allRelatedFields, relatedTsFields{
+				allIDFields: polyFields[:len(polyFields)-1],
+				objectField: polyFields[len(polyFields)-1],
+			})
 
 		case "HasOnePoly", "HasManyPoly":
 			// For polymorphic "Has" relationships, use the aliased model if provided, otherwise use relationship name
@@ -86,14 +137,22 @@ func getRelatedTsFieldsForMorpheModel(r *registry.Registry, modelRelations map[s
 			}
 
 			// Generate regular ID and object fields with the relationship name
-			tsIDField, tsIDErr := getRelatedTsFieldForMorpheModelPrimaryID(modelRelation.Type, relationshipName, targetModelDef)
+			tsIDField, tsIDErr := getRelatedTsFieldForMorpheModelPrimaryID(config, modelRelation.Type, relationshipName, targetModelDef)
 			if tsIDErr != nil {
 				return nil, tsIDErr
 			}
-			allFields = append(allFields, tsIDField)
 
-			tsRelatedField := getRelatedTsFieldForMorpheModelOptionalObjectWithTargetName(modelRelation.Type, relationshipName, targetModelName)
-			allFields = append(allFields, tsRelatedField)
+			tsRelatedField := getRelatedTsFieldForMorpheModelOptionalObjectWithTargetName(modelRelation.Type, relationshipName, config.TypeNaming.GetTypeName(targetModelName))
+			if modelRelation.Through != "" {
+				// Narrow the target to the records pointing back at this model through the polymorphic "For" relationship
+				tsRelatedField.Type = getPolymorphicThroughTsType(tsRelatedField.Type, modelRelation.Through, model.Name)
+			}
+			tsIDField.Docs = relationDocs
+			tsRelatedField.Docs = relationDocs
+			allRelatedFields = append(allRelatedFields, relatedTsFields{
+				allIDFields: []tsdef.ObjectField{tsIDField},
+				objectField: tsRelatedField,
+			})
 
 		default:
 			// Regular relationships
@@ -107,20 +166,24 @@ func getRelatedTsFieldsForMorpheModel(r *registry.Registry, modelRelations map[s
 				return nil, targetModelDefErr
 			}
 
-			tsIDField, tsIDErr := getRelatedTsFieldForMorpheModelPrimaryID(modelRelation.Type, relationshipName, targetModelDef)
+			tsIDField, tsIDErr := getRelatedTsFieldForMorpheModelPrimaryID(config, modelRelation.Type, relationshipName, targetModelDef)
 			if tsIDErr != nil {
 				return nil, tsIDErr
 			}
-			allFields = append(allFields, tsIDField)
 
-			tsRelatedField := getRelatedTsFieldForMorpheModelOptionalObjectWithTargetName(modelRelation.Type, relationshipName, targetModelName)
-			allFields = append(allFields, tsRelatedField)
+			tsRelatedField := getRelatedTsFieldForMorpheModelOptionalObjectWithTargetName(modelRelation.Type, relationshipName, config.TypeNaming.GetTypeName(targetModelName))
+			tsIDField.Docs = relationDocs
+			tsRelatedField.Docs = relationDocs
+			allRelatedFields = append(allRelatedFields, relatedTsFields{
+				allIDFields: []tsdef.ObjectField{tsIDField},
+				objectField: tsRelatedField,
+			})
 		}
 	}
-	return allFields, nil
+	return allRelatedFields, nil
 }
 
-func getEnumFieldAsTsFieldType(allEnums map[string]yaml.Enum, fieldName string, enumName string) tsdef.ObjectField {
+func getEnumFieldAsTsFieldType(enumsDirName string, allEnums map[string]yaml.Enum, fieldName string, enumName string) tsdef.ObjectField {
 	if len(allEnums) == 0 {
 		return tsdef.ObjectField{}
 	}
@@ -131,7 +194,7 @@ func getEnumFieldAsTsFieldType(allEnums map[string]yaml.Enum, fieldName string,
 	}
 
 	tsFieldType := tsdef.TsTypeObject{
-		ModulePath: "../enums/" + strcase.ToKebabCaseLower(enumName),
+		ModulePath: "../" + enumsDirName + "/" + strcase.ToKebabCaseLower(enumName),
 		Name:       enumName,
 	}
 	tsField := tsdef.ObjectField{
@@ -141,20 +204,16 @@ func getEnumFieldAsTsFieldType(allEnums map[string]yaml.Enum, fieldName string,
 	return tsField
 }
 
-func getRelatedTsFieldForMorpheModelPrimaryID(relationType string, relatedModelName string, relatedModelDef yaml.Model) (tsdef.ObjectField, error) {
+func getRelatedTsFieldForMorpheModelPrimaryID(config morpheModelCompileConfig, relationType string, relatedModelName string, relatedModelDef yaml.Model) (tsdef.ObjectField, error) {
 	relatedPrimaryIDFieldName, relatedIDFieldNameErr := yamlops.GetModelPrimaryIdentifierFieldName(relatedModelDef)
 	if relatedIDFieldNameErr != nil {
 		return tsdef.ObjectField{}, fmt.Errorf("related %w", relatedIDFieldNameErr)
 	}
 	idFieldName := strcase.ToCamelCase(fmt.Sprintf("%s%s", relatedModelName, relatedPrimaryIDFieldName))
 
-	relatedPrimaryIDFieldDef, relatedIDFieldDefErr := yamlops.GetModelFieldDefinitionByName(relatedModelDef, relatedPrimaryIDFieldName)
-	if relatedIDFieldDefErr != nil {
-		return tsdef.ObjectField{}, fmt.Errorf("related %w (primary identifier)", relatedIDFieldDefErr)
-	}
-	idFieldType, typeSupported := typemap.MorpheModelFieldToTsField[relatedPrimaryIDFieldDef.Type]
-	if !typeSupported {
-		return tsdef.ObjectField{}, ErrUnsupportedMorpheFieldType(relatedPrimaryIDFieldDef.Type)
+	idFieldType, typeErr := getRelatedMorpheModelPrimaryIDTsType(config, relatedModelDef, relatedPrimaryIDFieldName)
+	if typeErr != nil {
+		return tsdef.ObjectField{}, typeErr
 	}
 
 	if yamlops.IsRelationMany(relationType) {
@@ -178,6 +237,18 @@ func getRelatedTsFieldForMorpheModelPrimaryID(relationType string, relatedModelN
 	return tsIDField, nil
 }
 
+// getRelatedMorpheModelPrimaryIDTsType resolves the type of a related model's primary identifier, or its branded ID type
+func getRelatedMorpheModelPrimaryIDTsType(config morpheModelCompileConfig, relatedModelDef yaml.Model, relatedPrimaryIDFieldName string) (tsdef.TsType, error) {
+	relatedPrimaryIDFieldDef, relatedIDFieldDefErr := yamlops.GetModelFieldDefinitionByName(relatedModelDef, relatedPrimaryIDFieldName)
+	if relatedIDFieldDefErr != nil {
+		return nil, fmt.Errorf("related %w (primary identifier)", relatedIDFieldDefErr)
+	}
+	if brandedIDType, isBranded := getRelatedModelBrandedIDType(config, relatedModelDef); isBranded {
+		return brandedIDType, nil
+	}
+	return getTsTypeForMorpheModelFieldType(config.TypeMappings, relatedPrimaryIDFieldDef.Type)
+}
+
 func getRelatedTsFieldForMorpheModelOptionalObject(relationType string, relatedModelName string) tsdef.ObjectField {
 	if yamlops.IsRelationMany(relationType) {
 		tsRelatedField := tsdef.ObjectField{
@@ -236,21 +307,23 @@ func getRelatedTsFieldForMorpheModelOptionalObjectWithTargetName(relationType st
 	return tsRelatedField
 }
 
-func getPolymorphicForTsFields(r *registry.Registry, relationshipName string, modelRelation yaml.ModelRelation) ([]tsdef.ObjectField, error) {
-	if len(modelRelation.For) == 0 {
-		return nil, fmt.Errorf("polymorphic relation '%s' must have at least one model in 'for' property", relationshipName)
+func getPolymorphicForTsFields(config morpheModelCompileConfig, r *registry.Registry, relationshipName string, modelRelation yaml.ModelRelation) ([]tsdef.ObjectField, error) {
+	allTargets, targetsErr := getPolymorphicTargetsForMorpheModel(config, r, relationshipName, modelRelation)
+	if targetsErr != nil {
+		return nil, targetsErr
 	}
 
 	relationshipNameCamel := strcase.ToCamelCase(relationshipName)
 	allFields := []tsdef.ObjectField{}
 
 	// Add ID field(s)
+	idType := getPolymorphicIDTsType(allTargets)
 	if yamlops.IsRelationMany(modelRelation.Type) {
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "IDs",
 			Type: tsdef.TsTypeOptional{
 				ValueType: tsdef.TsTypeArray{
-					ValueType: tsdef.TsTypeString,
+					ValueType: idType,
 				},
 			},
 		})
@@ -258,7 +331,7 @@ func getPolymorphicForTsFields(r *registry.Registry, relationshipName string, mo
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "ID",
 			Type: tsdef.TsTypeOptional{
-				ValueType: tsdef.TsTypeString,
+				ValueType: idType,
 			},
 		})
 	}
@@ -267,27 +340,17 @@ func getPolymorphicForTsFields(r *registry.Registry, relationshipName string, mo
 	allFields = append(allFields, tsdef.ObjectField{
 		Name: relationshipNameCamel + "Type",
 		Type: tsdef.TsTypeOptional{
-			ValueType: tsdef.TsTypeString,
+			ValueType: getPolymorphicTypeTsType(allTargets),
 		},
 	})
 
 	// Add union type field
-	unionTypes := []tsdef.TsType{}
-	for _, targetModelName := range modelRelation.For {
-		unionTypes = append(unionTypes, tsdef.TsTypeObject{
-			ModulePath: "./" + strcase.ToKebabCaseLower(targetModelName),
-			Name:       targetModelName,
-		})
-	}
-
 	if yamlops.IsRelationMany(modelRelation.Type) {
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel + "s",
 			Type: tsdef.TsTypeOptional{
 				ValueType: tsdef.TsTypeArray{
-					ValueType: tsdef.TsTypeUnion{
-						Types: unionTypes,
-					},
+					ValueType: getPolymorphicValueTsType(allTargets),
 				},
 			},
 		})
@@ -295,12 +358,75 @@ func getPolymorphicForTsFields(r *registry.Registry, relationshipName string, mo
 		allFields = append(allFields, tsdef.ObjectField{
 			Name: relationshipNameCamel,
 			Type: tsdef.TsTypeOptional{
-				ValueType: tsdef.TsTypeUnion{
-					Types: unionTypes,
-				},
+				ValueType: getPolymorphicValueTsType(allTargets),
 			},
 		})
 	}
 
 	return allFields, nil
 }
+
+func getPolymorphicTargetsForMorpheModel(config morpheModelCompileConfig, r *registry.Registry, relationshipName string, modelRelation yaml.ModelRelation) ([]polymorphicTarget, error) {
+	if len(modelRelation.For) == 0 {
+		return nil, fmt.Errorf("polymorphic relation '%s' must have at least one model in 'for' property", relationshipName)
+	}
+
+	allTargets := []polymorphicTarget{}
+	for _, targetModelName := range modelRelation.For {
+		targetModelDef, targetModelDefErr := r.GetModel(targetModelName)
+		if targetModelDefErr != nil {
+			return nil, targetModelDefErr
+		}
+		targetPrimaryIDFieldName, targetIDFieldNameErr := yamlops.GetModelPrimaryIdentifierFieldName(targetModelDef)
+		if targetIDFieldNameErr != nil {
+			return nil, fmt.Errorf("related %w", targetIDFieldNameErr)
+		}
+		targetIDType, targetIDTypeErr := getRelatedMorpheModelPrimaryIDTsType(config, targetModelDef, targetPrimaryIDFieldName)
+		if targetIDTypeErr != nil {
+			return nil, targetIDTypeErr
+		}
+		allTargets = append(allTargets, polymorphicTarget{
+			name:     targetModelName,
+			typeName: config.TypeNaming.GetTypeName(targetModelName),
+			idType:   targetIDType,
+		})
+	}
+	return allTargets, nil
+}
+
+// getMorpheModelForValidation returns the model as validated by Morphe.
+//
+// Morphe only checks the inverse "For" relationship of aliased polymorphic "Has" relationships, so the target of
+// unaliased ones is aliased to the relationship name.
+func getMorpheModelForValidation(model yaml.Model) yaml.Model {
+	validationModel := model.DeepClone()
+	for relationshipName, modelRelation := range model.Related {
+		if !yamlops.IsRelationPolyHas(modelRelation.Type) || modelRelation.Through == "" || modelRelation.Aliased != "" {
+			continue
+		}
+		modelRelation.Aliased = relationshipName
+		validationModel.Related[relationshipName] = modelRelation
+	}
+	return validationModel
+}
+
+// getAllModelPolymorphicUnionObjectTypes returns the discriminated union types of all polymorphic for-relations of a model.
+func getAllModelPolymorphicUnionObjectTypes(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model, modelType *tsdef.Object) ([]*tsdef.Object, error) {
+	if !config.DiscriminatedUnions {
+		return nil, nil
+	}
+
+	allUnionObjectTypes := []*tsdef.Object{}
+	for _, relationshipName := range core.MapKeysSorted(model.Related) {
+		modelRelation := model.Related[relationshipName]
+		if !yamlops.IsRelationPolyFor(modelRelation.Type) {
+			continue
+		}
+		allTargets, targetsErr := getPolymorphicTargetsForMorpheModel(config, r, relationshipName, modelRelation)
+		if targetsErr != nil {
+			return nil, targetsErr
+		}
+		allUnionObjectTypes = append(allUnionObjectTypes, getPolymorphicUnionObjectTypes(modelType.Name, relationshipName, allTargets)...)
+	}
+	return allUnionObjectTypes, nil
+}
@@ -0,0 +1,108 @@
+package compile
+
+import (
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/morphe-go/pkg/registry"
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const (
+	modelCreateTypeSuffix = "Create"
+	modelUpdateTypeSuffix = "Update"
+)
+
+// getAllModelInputObjectTypes derives the `PersonCreate` and `PersonUpdate` input types from the model type.
+//
+// Both reference relations by their ID fields only. Create inputs omit `AutoIncrement` fields and only require
+// `mandatory` fields. Update inputs also omit `immutable` fields and make all fields except the primary identifier optional.
+func getAllModelInputObjectTypes(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model, modelType *tsdef.Object) ([]*tsdef.Object, error) {
+	if !config.InputTypes {
+		return nil, nil
+	}
+
+	allRelatedFields, relatedErr := getAllRelatedTsFieldsForMorpheModel(config, r, model)
+	if relatedErr != nil {
+		return nil, relatedErr
+	}
+	allRelatedIDFields := []tsdef.ObjectField{}
+	for _, relatedFields := range allRelatedFields {
+		allRelatedIDFields = append(allRelatedIDFields, relatedFields.allIDFields...)
+	}
+
+	createType := tsdef.Object{
+		Name: modelType.Name + modelCreateTypeSuffix,
+	}
+	updateType := tsdef.Object{
+		Name: modelType.Name + modelUpdateTypeSuffix,
+	}
+
+	allPrimaryIDFieldNames := model.Identifiers["primary"].Fields
+	for _, fieldName := range core.MapKeysSorted(model.Fields) {
+		fieldDef := model.Fields[fieldName]
+		modelField, fieldErr := getModelObjectTypeField(modelType, fieldName)
+		if fieldErr != nil {
+			return nil, fieldErr
+		}
+		inputFieldType := getTsTypeWithoutOptional(modelField.Type)
+
+		isPrimaryID := slices.Contains(allPrimaryIDFieldNames, fieldName)
+		isGenerated := fieldDef.Type == yaml.ModelFieldTypeAutoIncrement
+		isMandatory := hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeMandatory)
+		isImmutable := hasMorpheFieldAttribute(fieldDef.Attributes, morpheFieldAttributeImmutable)
+
+		if !isGenerated {
+			createType.Fields = append(createType.Fields, getInputObjectField(modelField, inputFieldType, isMandatory))
+		}
+		if isPrimaryID || (!isGenerated && !isImmutable) {
+			updateType.Fields = append(updateType.Fields, getInputObjectField(modelField, inputFieldType, isPrimaryID))
+		}
+	}
+	createType.Fields = append(createType.Fields, allRelatedIDFields...)
+	updateType.Fields = append(updateType.Fields, allRelatedIDFields...)
+
+	return []*tsdef.Object{
+		&createType,
+		&updateType,
+	}, nil
+}
+
+func getModelObjectTypeField(modelType *tsdef.Object, fieldName string) (tsdef.ObjectField, error) {
+	tsFieldName := strcase.ToCamelCase(fieldName)
+	for _, modelField := range modelType.Fields {
+		if modelField.Name == tsFieldName {
+			return modelField, nil
+		}
+	}
+	return tsdef.ObjectField{}, ErrMissingMorpheModelField(modelType.Name, fieldName)
+}
+
+func getInputObjectField(modelField tsdef.ObjectField, fieldType tsdef.TsType, isRequired bool) tsdef.ObjectField {
+	if isRequired {
+		return tsdef.ObjectField{
+			Name:     modelField.Name,
+			Type:     fieldType,
+			Docs:     modelField.Docs,
+			DateOnly: modelField.DateOnly,
+		}
+	}
+	return tsdef.ObjectField{
+		Name: modelField.Name,
+		Type: tsdef.TsTypeOptional{
+			ValueType: fieldType,
+		},
+		Docs:     modelField.Docs,
+		DateOnly: modelField.DateOnly,
+	}
+}
+
+func getTsTypeWithoutOptional(tsType tsdef.TsType) tsdef.TsType {
+	optionalType, isOptional := tsType.(tsdef.TsTypeOptional)
+	if !isOptional {
+		return tsType
+	}
+	return optionalType.ValueType
+}
@@ -2,6 +2,7 @@ package compile
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/kalo-build/clone"
 	"github.com/kalo-build/go-util/core"
@@ -14,9 +15,16 @@ import (
 )
 
 func AllMorpheModelsToTsObjects(config MorpheCompileConfig, r *registry.Registry) (map[string][]*tsdef.Object, error) {
+	modelsConfig := config.MorpheModelsConfig
+	allSourceFileNames, sourceFileNamesErr := getMorpheSourceFileNamesOrLoad(modelsConfig.SourceFileNames, config.RegistryModelsDirPath, registry.ModelFileSuffix)
+	if sourceFileNamesErr != nil {
+		return nil, sourceFileNamesErr
+	}
+	modelsConfig.SourceFileNames = allSourceFileNames
+
 	allModelTypeDefs := map[string][]*tsdef.Object{}
 	for modelName, model := range r.GetAllModels() {
-		modelTypes, modelErr := MorpheModelToTsObjects(config.ModelHooks, config.MorpheModelsConfig, r, model)
+		modelTypes, modelErr := MorpheModelToTsObjects(config.ModelHooks, modelsConfig, config.OutputLayout, r, model)
 		if modelErr != nil {
 			return nil, modelErr
 		}
@@ -25,7 +33,14 @@ func AllMorpheModelsToTsObjects(config MorpheCompileConfig, r *registry.Registry
 	return allModelTypeDefs, nil
 }
 
-func MorpheModelToTsObjects(modelHooks hook.CompileMorpheModel, config cfg.MorpheModelsConfig, r *registry.Registry, model yaml.Model) ([]*tsdef.Object, error) {
+// morpheModelCompileConfig is the models config alongside the output layout the sibling enums and structures are
+// imported from.
+type morpheModelCompileConfig struct {
+	cfg.MorpheModelsConfig
+	OutputLayout cfg.OutputLayout
+}
+
+func MorpheModelToTsObjects(modelHooks hook.CompileMorpheModel, config cfg.MorpheModelsConfig, outputLayout cfg.OutputLayout, r *registry.Registry, model yaml.Model) ([]*tsdef.Object, error) {
 	if r == nil {
 		return nil, triggerCompileMorpheModelFailure(modelHooks, config, model, ErrNoRegistry)
 	}
@@ -33,7 +48,11 @@ func MorpheModelToTsObjects(modelHooks hook.CompileMorpheModel, config cfg.Morph
 	if compileStartErr != nil {
 		return nil, triggerCompileMorpheModelFailure(modelHooks, config, model, compileStartErr)
 	}
-	allModelTypes, objectsErr := morpheModelToTsObjectTypes(config, r, model)
+	compileConfig := morpheModelCompileConfig{
+		MorpheModelsConfig: config,
+		OutputLayout:       outputLayout,
+	}
+	allModelTypes, objectsErr := morpheModelToTsObjectTypes(compileConfig, r, model)
 	if objectsErr != nil {
 		return nil, triggerCompileMorpheModelFailure(modelHooks, config, model, objectsErr)
 	}
@@ -45,17 +64,21 @@ func MorpheModelToTsObjects(modelHooks hook.CompileMorpheModel, config cfg.Morph
 	return allModelTypes, nil
 }
 
-func morpheModelToTsObjectTypes(config cfg.MorpheModelsConfig, r *registry.Registry, model yaml.Model) ([]*tsdef.Object, error) {
+func morpheModelToTsObjectTypes(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) ([]*tsdef.Object, error) {
 	validateConfigErr := config.Validate()
 	if validateConfigErr != nil {
 		return nil, validateConfigErr
 	}
-	validateMorpheErr := model.Validate(r.GetAllEnums())
+	validateLayoutErr := config.OutputLayout.Validate()
+	if validateLayoutErr != nil {
+		return nil, validateLayoutErr
+	}
+	validateMorpheErr := getMorpheModelForValidation(model).ValidateWithModels(r.GetAllModels(), getMorpheFieldTypeValidationEnums(r))
 	if validateMorpheErr != nil {
 		return nil, validateMorpheErr
 	}
 
-	modelType, modelTypeErr := getModelObjectType(r, model)
+	modelType, modelTypeErr := getModelObjectType(config, r, model)
 	if modelTypeErr != nil {
 		return nil, modelTypeErr
 	}
@@ -64,10 +87,41 @@ func morpheModelToTsObjectTypes(config cfg.MorpheModelsConfig, r *registry.Regis
 		return nil, identifierTypesErr
 	}
 
+	brandedIDType, brandedIDTypeErr := getModelBrandedIDObjectType(config, model)
+	if brandedIDTypeErr != nil {
+		return nil, brandedIDTypeErr
+	}
+
 	allModelTypes := []*tsdef.Object{
 		modelType,
 	}
+	if brandedIDType != nil {
+		modelType.Imports = mergeObjectImports(modelType.Imports, brandedIDType.Alias.GetImports())
+		allModelTypes = append(allModelTypes, brandedIDType)
+	}
 	allModelTypes = append(allModelTypes, allIdentifierTypes...)
+
+	allUnionTypes, unionTypesErr := getAllModelPolymorphicUnionObjectTypes(config, r, model, modelType)
+	if unionTypesErr != nil {
+		return nil, unionTypesErr
+	}
+	for _, unionType := range allUnionTypes {
+		for _, unionField := range unionType.Fields {
+			modelType.Imports = mergeObjectImports(modelType.Imports, unionField.Type.GetImports())
+		}
+	}
+	allModelTypes = append(allModelTypes, allUnionTypes...)
+
+	allInputTypes, inputTypesErr := getAllModelInputObjectTypes(config, r, model, modelType)
+	if inputTypesErr != nil {
+		return nil, inputTypesErr
+	}
+	allModelTypes = append(allModelTypes, allInputTypes...)
+
+	identifiersErr := validateTsObjectIdentifiers(config.IdentifierHandling, getMorpheModelIdentifierSource(model), allModelTypes)
+	if identifiersErr != nil {
+		return nil, identifiersErr
+	}
 	return allModelTypes, nil
 }
 
@@ -108,11 +162,12 @@ func triggerCompileMorpheModelFailure(hooks hook.CompileMorpheModel, config cfg.
 	return hooks.OnCompileMorpheModelFailure(config, model.DeepClone(), failureErr)
 }
 
-func getModelObjectType(r *registry.Registry, model yaml.Model) (*tsdef.Object, error) {
+func getModelObjectType(config morpheModelCompileConfig, r *registry.Registry, model yaml.Model) (*tsdef.Object, error) {
 	modelType := tsdef.Object{
-		Name: model.Name,
+		Name: config.TypeNaming.GetTypeName(model.Name),
+		Docs: getMorpheModelSourceDocs(config.MorpheModelsConfig, model.Name),
 	}
-	typeFields, fieldsErr := getTsFieldsForMorpheModel(r, model.Fields, model.Related)
+	typeFields, fieldsErr := getTsFieldsForMorpheModel(config, r, model)
 	if fieldsErr != nil {
 		return nil, fieldsErr
 	}
@@ -165,10 +220,7 @@ func getModelIdentifierObjectFieldSubset(modelType tsdef.Object, identifierName
 			if modelFieldDef.Name != tsFieldName {
 				continue
 			}
-			identifierFieldDef = tsdef.ObjectField{
-				Name: modelFieldDef.Name,
-				Type: modelFieldDef.Type,
-			}
+			identifierFieldDef = modelFieldDef
 		}
 		if identifierFieldDef.Name == "" {
 			return nil, ErrMissingMorpheIdentifierField(modelType.Name, identifierName, fieldName)
@@ -183,15 +235,43 @@ func getImportsForObjectFields(allFields []tsdef.ObjectField) ([]tsdef.ObjectImp
 	for _, fieldDef := range allFields {
 		allFieldImports := fieldDef.Type.GetImports()
 		for _, fieldImport := range allFieldImports {
-			objectImportMap[fieldImport.ModulePath] = fieldImport
+			addObjectImport(objectImportMap, fieldImport)
 		}
 	}
 
+	return getSortedObjectImports(objectImportMap), nil
+}
+
+// mergeObjectImports adds the imports of a non-field type (e.g. a type alias) to the imports of the file's main object
+func mergeObjectImports(allObjectImports []tsdef.ObjectImport, allOtherImports []tsdef.ObjectImport) []tsdef.ObjectImport {
+	objectImportMap := map[string]tsdef.ObjectImport{}
+	for _, objectImport := range append(allObjectImports, allOtherImports...) {
+		addObjectImport(objectImportMap, objectImport)
+	}
+	return getSortedObjectImports(objectImportMap)
+}
+
+func getSortedObjectImports(objectImportMap map[string]tsdef.ObjectImport) []tsdef.ObjectImport {
 	allModulePaths := core.MapKeysSorted(objectImportMap)
 
 	allObjectImports := []tsdef.ObjectImport{}
 	for _, modulePath := range allModulePaths {
 		allObjectImports = append(allObjectImports, objectImportMap[modulePath])
 	}
-	return allObjectImports, nil
+	return allObjectImports
+}
+
+// addObjectImport merges the module names of imports sharing a module path
+func addObjectImport(objectImportMap map[string]tsdef.ObjectImport, objectImport tsdef.ObjectImport) {
+	existingImport, importExists := objectImportMap[objectImport.ModulePath]
+	if !importExists {
+		objectImportMap[objectImport.ModulePath] = objectImport
+		return
+	}
+	for _, moduleName := range objectImport.ModuleNames {
+		if !slices.Contains(existingImport.ModuleNames, moduleName) {
+			existingImport.ModuleNames = append(slices.Clone(existingImport.ModuleNames), moduleName)
+		}
+	}
+	objectImportMap[objectImport.ModulePath] = existingImport
 }
@@ -81,7 +81,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects() {
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -103,6 +103,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects() {
 	tsField02 := tsFields0[2]
 	suite.Equal(tsField02.Name, "date")
 	suite.Equal(tsField02.Type, tsdef.TsTypeDate)
+	suite.True(tsField02.DateOnly)
 
 	tsField03 := tsFields0[3]
 	suite.Equal(tsField03.Name, "float")
@@ -127,10 +128,12 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects() {
 	tsField08 := tsFields0[8]
 	suite.Equal(tsField08.Name, "time")
 	suite.Equal(tsField08.Type, tsdef.TsTypeDate)
+	suite.False(tsField08.Readonly)
 
 	tsField09 := tsFields0[9]
 	suite.Equal(tsField09.Name, "uuid")
 	suite.Equal(tsField09.Type, tsdef.TsTypeString)
+	suite.True(tsField09.Readonly)
 
 	tsObject1 := allTsObjects[1]
 	suite.Equal(tsObject1.Name, "BasicIDPrimary")
@@ -141,6 +144,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects() {
 	tsField10 := tsFields1[0]
 	suite.Equal(tsField10.Name, "uuid")
 	suite.Equal(tsField10.Type, tsdef.TsTypeString)
+	suite.True(tsField10.Readonly)
 }
 
 func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_EnumField() {
@@ -187,7 +191,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_EnumField() {
 	r := registry.NewRegistry()
 	r.SetEnum("Nationality", enum0)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -224,6 +228,79 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_EnumField() {
 	suite.Equal(tsField10.Type, tsdef.TsTypeString)
 }
 
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_StructureField() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"Nationality": {
+				Type: "Nationality",
+			},
+			"ShippingAddress": {
+				Type: "Address",
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	enum0 := yaml.Enum{
+		Name: "Nationality",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"US": "American",
+		},
+	}
+
+	structure0 := yaml.Structure{
+		Name: "Address",
+		Fields: map[string]yaml.StructureField{
+			"Street": {
+				Type: yaml.StructureFieldTypeString,
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", enum0)
+	r.SetStructure("Address", structure0)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Basic")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 3)
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "shippingAddress")
+	suite.Equal(tsField02.Type, tsdef.TsTypeObject{
+		ModulePath: "../structures/address",
+		Name:       "Address",
+	})
+
+	suite.Contains(tsObject0.Imports, tsdef.ObjectImport{
+		ModuleNames: []string{"Address"},
+		ModulePath:  "../structures/address",
+	})
+}
+
 func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_EnumField_EnumNotFound() {
 	modelHooks := hook.CompileMorpheModel{}
 
@@ -257,7 +334,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_EnumField_EnumNo
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "unsupported morphe field type for typescript conversion: 'Nationality'")
@@ -320,7 +397,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOne()
 	r.SetModel("Basic", model0)
 	r.SetModel("BasicParent", model1)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -420,7 +497,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForMany(
 	r.SetModel("Basic", model0)
 	r.SetModel("BasicParent", model1)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -524,7 +601,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasOne()
 	r.SetModel("Basic", model0)
 	r.SetModel("BasicParent", model1)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model1)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model1)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -624,7 +701,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasMany(
 	r.SetModel("Basic", model0)
 	r.SetModel("BasicParent", model1)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model1)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model1)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -697,7 +774,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_NoModelName() {
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "morphe model has no name")
@@ -725,7 +802,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_NoFields() {
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "morphe model has no fields")
@@ -751,7 +828,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_NoIdentifiers()
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "morphe model has no identifiers")
@@ -799,7 +876,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_StartHook_Succes
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -864,7 +941,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_StartHook_Failur
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "compile model start hook error")
@@ -920,7 +997,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_SuccessHook_Succ
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -985,7 +1062,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_SuccessHook_Fail
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "compile model success hook error")
@@ -1017,7 +1094,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_FailureHook_NoMo
 
 	r := registry.NewRegistry()
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, model0)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
 
 	suite.NotNil(allTsObjectsErr)
 	suite.ErrorContains(allTsObjectsErr, "Model Basic: morphe model has no identifiers")
@@ -1094,7 +1171,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOnePo
 	r.SetModel("Article", articleModel)
 	r.SetModel("Comment", commentModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, commentModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, commentModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1129,7 +1206,12 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOnePo
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "commentableType")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeString,
+		ValueType: tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsdef.TsTypeStringLiteral{Value: "Post"},
+				tsdef.TsTypeStringLiteral{Value: "Article"},
+			},
+		},
 	})
 
 	// Polymorphic object field (union type)
@@ -1231,7 +1313,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForManyP
 	r.SetModel("Company", companyModel)
 	r.SetModel("Tag", tagModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, tagModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, tagModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1260,7 +1342,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForManyP
 	suite.Equal(tsField03.Name, "taggableIDs")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
 		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeString,
+			ValueType: tsdef.TsTypeNumber,
 		},
 	})
 
@@ -1268,7 +1350,12 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForManyP
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "taggableType")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeString,
+		ValueType: tsdef.TsTypeUnion{
+			Types: []tsdef.TsType{
+				tsdef.TsTypeStringLiteral{Value: "Person"},
+				tsdef.TsTypeStringLiteral{Value: "Company"},
+			},
+		},
 	})
 
 	// Polymorphic object field (union type array)
@@ -1361,7 +1448,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasOnePo
 	r.SetModel("Comment", commentModel)
 	r.SetModel("Person", personModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, personModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, personModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1395,9 +1482,13 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasOnePo
 	tsField04 := tsFields0[4]
 	suite.Equal(tsField04.Name, "comment")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeObject{
-			ModulePath: "./comment",
-			Name:       "Comment",
+		ValueType: tsdef.TsTypeNarrowed{
+			ValueType: tsdef.TsTypeObject{
+				ModulePath: "./comment",
+				Name:       "Comment",
+			},
+			FieldName: "commentableType",
+			FieldType: tsdef.TsTypeStringLiteral{Value: "Person"},
 		},
 	})
 
@@ -1471,7 +1562,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyP
 	r.SetModel("Tag", tagModel)
 	r.SetModel("Person", personModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, personModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, personModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1508,9 +1599,13 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyP
 	suite.Equal(tsField04.Name, "tags")
 	suite.Equal(tsField04.Type, tsdef.TsTypeOptional{
 		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeObject{
-				ModulePath: "./tag",
-				Name:       "Tag",
+			ValueType: tsdef.TsTypeNarrowed{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./tag",
+					Name:       "Tag",
+				},
+				FieldName: "taggableType",
+				FieldType: tsdef.TsTypeStringLiteral{Value: "Person"},
 			},
 		},
 	})
@@ -1582,7 +1677,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasOnePo
 	r.SetModel("Comment", commentModel)
 	r.SetModel("Post", postModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, postModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, postModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1612,9 +1707,13 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasOnePo
 	tsField03 := tsFields0[3]
 	suite.Equal(tsField03.Name, "comment")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
-		ValueType: tsdef.TsTypeObject{
-			ModulePath: "./comment",
-			Name:       "Comment",
+		ValueType: tsdef.TsTypeNarrowed{
+			ValueType: tsdef.TsTypeObject{
+				ModulePath: "./comment",
+				Name:       "Comment",
+			},
+			FieldName: "commentableType",
+			FieldType: tsdef.TsTypeStringLiteral{Value: "Post"},
 		},
 	})
 
@@ -1685,7 +1784,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyP
 	r.SetModel("Tag", tagModel)
 	r.SetModel("Post", postModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, postModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, postModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1718,9 +1817,13 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyP
 	suite.Equal(tsField03.Name, "tags")
 	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
 		ValueType: tsdef.TsTypeArray{
-			ValueType: tsdef.TsTypeObject{
-				ModulePath: "./tag",
-				Name:       "Tag",
+			ValueType: tsdef.TsTypeNarrowed{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./tag",
+					Name:       "Tag",
+				},
+				FieldName: "taggableType",
+				FieldType: tsdef.TsTypeStringLiteral{Value: "Post"},
 			},
 		},
 	})
@@ -1736,6 +1839,110 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyP
 	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
 }
 
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyPoly_ThroughMissingFor() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{}
+
+	tagModel := yaml.Model{
+		Name: "Tag",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Taggable": {
+				Type: "ForManyPoly",
+				For:  []string{"Comment"},
+			},
+		},
+	}
+
+	postModel := yaml.Model{
+		Name: "Post",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Tag": {
+				Type:    "HasManyPoly",
+				Through: "Taggable",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("Tag", tagModel)
+	r.SetModel("Post", postModel)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, postModel)
+
+	suite.Nil(allTsObjects)
+	suite.ErrorContains(allTsObjectsErr, "morphe model 'Post' polymorphic inverse relation 'Tag' (aliased: Tag, through: Taggable): current model 'Post' is not in the 'for' list of polymorphic relationship 'Taggable' in model 'Tag'")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_HasManyPoly_ThroughMissingRelation() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{}
+
+	tagModel := yaml.Model{
+		Name: "Tag",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	}
+
+	postModel := yaml.Model{
+		Name: "Post",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Tag": {
+				Type:    "HasManyPoly",
+				Through: "Taggable",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("Tag", tagModel)
+	r.SetModel("Post", postModel)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, postModel)
+
+	suite.Nil(allTsObjects)
+	suite.ErrorContains(allTsObjectsErr, "morphe model 'Post' polymorphic inverse relation 'Tag' (aliased: Tag, through: Taggable): aliased model 'Tag' does not have relationship 'Taggable'")
+}
+
 func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOne_Aliased() {
 	modelHooks := hook.CompileMorpheModel{}
 
@@ -1792,7 +1999,7 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOne_A
 	r.SetModel("Contact", contactModel)
 	r.SetModel("Person", personModel)
 
-	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, r, personModel)
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, personModel)
 
 	suite.Nil(allTsObjectsErr)
 	suite.Len(allTsObjects, 2)
@@ -1854,3 +2061,1176 @@ func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Related_ForOne_A
 	suite.Equal(tsField10.Name, "id")
 	suite.Equal(tsField10.Type, tsdef.TsTypeNumber)
 }
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_FieldOptionality_Optional() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		FieldOptionality: cfg.FieldOptionalityOptional,
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+				Attributes: []string{
+					"mandatory",
+				},
+			},
+			"Nationality": {
+				Type: "Nationality",
+			},
+			"String": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+			"name": {
+				Fields: []string{
+					"String",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	enum0 := yaml.Enum{
+		Name: "Nationality",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"US": "American",
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", enum0)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 3)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Basic")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 3)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "id")
+	suite.Equal(tsField00.Type, tsdef.TsTypeNumber)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "nationality")
+	suite.Equal(tsField01.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeObject{
+			ModulePath: "../enums/nationality",
+			Name:       "Nationality",
+		},
+	})
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "string")
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicIDName")
+
+	tsFields1 := tsObject1.Fields
+	suite.Len(tsFields1, 1)
+
+	tsField10 := tsFields1[0]
+	suite.Equal(tsField10.Name, "string")
+	suite.Equal(tsField10.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeString,
+	})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_FieldOptionality_Nullable() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		FieldOptionality: cfg.FieldOptionalityNullable,
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+				Attributes: []string{
+					"mandatory",
+				},
+			},
+			"String": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Basic")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 2)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "id")
+	suite.Equal(tsField00.Type, tsdef.TsTypeNumber)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "string")
+	suite.Equal(tsField01.Type, tsdef.TsTypeUnion{
+		Types: []tsdef.TsType{
+			tsdef.TsTypeString,
+			tsdef.TsTypeNull,
+		},
+	})
+	suite.Equal(tsField01.Type.GetSyntax(), "string | null")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_FieldOptionality_Unsupported() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		FieldOptionality: "maybe",
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.ErrorContains(allTsObjectsErr, "unsupported field optionality 'maybe'")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_TypeNaming() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		TypeNaming: cfg.TypeNaming{
+			Suffix: "Model",
+		},
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"BasicParent": {
+				Type: "ForOne",
+			},
+		},
+	}
+	model1 := yaml.Model{
+		Name: "BasicParent",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Basic": {
+				Type: "HasMany",
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+	r.SetModel("BasicParent", model1)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "BasicModel")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 3)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "basicParentID")
+	suite.Equal(tsField01.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeNumber,
+	})
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "basicParent")
+	suite.Equal(tsField02.Type, tsdef.TsTypeOptional{
+		ValueType: tsdef.TsTypeObject{
+			ModulePath: "./basic-parent-model",
+			Name:       "BasicParentModel",
+		},
+	})
+
+	suite.Equal(tsObject0.Imports, []tsdef.ObjectImport{
+		{
+			ModuleNames: []string{"BasicParentModel"},
+			ModulePath:  "./basic-parent-model",
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicModelIDPrimary")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_TypeNaming_InvalidPrefix() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		TypeNaming: cfg.TypeNaming{
+			Prefix: "1-",
+		},
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.ErrorContains(allTsObjectsErr, "invalid type name prefix '1-'")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_TypeMappings() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		TypeMappings: cfg.TypeMappings{
+			"UUID": {
+				TypeName:   "Uuid",
+				ModulePath: "@acme/types",
+			},
+			"Time":   {TypeName: "string"},
+			"Sealed": {TypeName: "never"},
+		},
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"CreatedAt": {
+				Type: yaml.ModelFieldTypeTime,
+			},
+			"ID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+			"Secret": {
+				Type: yaml.ModelFieldTypeSealed,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"BasicParent": {
+				Type: "ForOne",
+			},
+		},
+	}
+	model1 := yaml.Model{
+		Name: "BasicParent",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+	r.SetModel("BasicParent", model1)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	uuidType := tsdef.TsTypeObject{
+		ModulePath: "@acme/types",
+		Name:       "Uuid",
+	}
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Basic")
+
+	tsFields0 := tsObject0.Fields
+	suite.Len(tsFields0, 5)
+
+	tsField00 := tsFields0[0]
+	suite.Equal(tsField00.Name, "createdAt")
+	suite.Equal(tsField00.Type, tsdef.TsTypeString)
+
+	tsField01 := tsFields0[1]
+	suite.Equal(tsField01.Name, "id")
+	suite.Equal(tsField01.Type, uuidType)
+
+	tsField02 := tsFields0[2]
+	suite.Equal(tsField02.Name, "secret")
+	suite.Equal(tsField02.Type, tsdef.TsTypePrimitive{Syntax: "never"})
+
+	tsField03 := tsFields0[3]
+	suite.Equal(tsField03.Name, "basicParentID")
+	suite.Equal(tsField03.Type, tsdef.TsTypeOptional{
+		ValueType: uuidType,
+	})
+
+	suite.Equal(tsObject0.Imports, []tsdef.ObjectImport{
+		{
+			ModuleNames: []string{"BasicParent"},
+			ModulePath:  "./basic-parent",
+		},
+		{
+			ModuleNames: []string{"Uuid"},
+			ModulePath:  "@acme/types",
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicIDPrimary")
+	suite.Equal(tsObject1.Fields, []tsdef.ObjectField{
+		{
+			Name: "id",
+			Type: uuidType,
+		},
+	})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_TypeMappings_Invalid() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+
+	allInvalidTypeMappings := map[string]cfg.TypeMappings{
+		"unsupported type mapping for 'Nationality', must be a primitive morphe field type": {
+			"Nationality": {TypeName: "string"},
+		},
+		"invalid type mapping 'string[]' for 'String', must be a valid typescript type name": {
+			"String": {TypeName: "string[]"},
+		},
+		"type mapping 'Uuid' for 'UUID' is not a builtin type and requires a module path": {
+			"UUID": {TypeName: "Uuid"},
+		},
+		"invalid type mapping module path './uuid' for 'UUID', must be a package module path or path alias (e.g. '@acme/types'), relative and absolute paths are not supported": {
+			"UUID": {TypeName: "Uuid", ModulePath: "./uuid"},
+		},
+		"invalid type mapping module path '/src/types/uuid' for 'UUID', must be a package module path or path alias (e.g. '@acme/types'), relative and absolute paths are not supported": {
+			"UUID": {TypeName: "Uuid", ModulePath: "/src/types/uuid"},
+		},
+		"invalid type mapping 'string' for 'UUID', must be a valid typescript type name": {
+			"UUID": {TypeName: "string", ModulePath: "@acme/types"},
+		},
+	}
+
+	for expectedErr, typeMappings := range allInvalidTypeMappings {
+		modelsConfig := cfg.MorpheModelsConfig{
+			TypeMappings: typeMappings,
+		}
+
+		allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+		suite.EqualError(allTsObjectsErr, expectedErr)
+		suite.Nil(allTsObjects)
+	}
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_BrandedIDs() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		BrandedIDs: true,
+		TypeNaming: cfg.TypeNaming{
+			Suffix: "Model",
+		},
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"BasicParent": {
+				Type: "ForOne",
+			},
+		},
+	}
+	model1 := yaml.Model{
+		Name: "BasicParent",
+		Fields: map[string]yaml.ModelField{
+			"UUID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"UUID",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+	r.SetModel("BasicParent", model1)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 3)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "BasicModel")
+	suite.Equal(tsObject0.Fields, []tsdef.ObjectField{
+		{
+			Name: "id",
+			Type: tsdef.TsTypeObject{
+				Name: "BasicModelID",
+			},
+		},
+		{
+			Name: "basicParentUUID",
+			Type: tsdef.TsTypeOptional{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./basic-parent-model",
+					Name:       "BasicParentModelID",
+				},
+			},
+			Docs: []string{"`ForOne` relation to `BasicParent`"},
+		},
+		{
+			Name: "basicParent",
+			Type: tsdef.TsTypeOptional{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./basic-parent-model",
+					Name:       "BasicParentModel",
+				},
+			},
+			Docs: []string{"`ForOne` relation to `BasicParent`"},
+		},
+	})
+	suite.Equal(tsObject0.Imports, []tsdef.ObjectImport{
+		{
+			ModuleNames: []string{"BasicParentModelID", "BasicParentModel"},
+			ModulePath:  "./basic-parent-model",
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicModelID")
+	suite.Equal(tsObject1.Alias, tsdef.TsTypeBranded{
+		ValueType: tsdef.TsTypeNumber,
+		Brand:     "BasicModelID",
+	})
+	suite.Equal(tsObject1.Alias.GetSyntax(), `number & { readonly __brand: "BasicModelID" }`)
+
+	tsObject2 := allTsObjects[2]
+	suite.Equal(tsObject2.Name, "BasicModelIDPrimary")
+	suite.Equal(tsObject2.Fields, []tsdef.ObjectField{
+		{
+			Name: "id",
+			Type: tsdef.TsTypeObject{
+				Name: "BasicModelID",
+			},
+		},
+	})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_BrandedIDs_CompositePrimary() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		BrandedIDs: true,
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"TenantID": {
+				Type: yaml.ModelFieldTypeInteger,
+			},
+			"Slug": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"TenantID",
+					"Slug",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Fields, []tsdef.ObjectField{
+		{
+			Name: "slug",
+			Type: tsdef.TsTypeString,
+		},
+		{
+			Name: "tenantID",
+			Type: tsdef.TsTypeNumber,
+		},
+	})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BasicIDPrimary")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_InputTypes() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		InputTypes:       true,
+		FieldOptionality: cfg.FieldOptionalityNullable,
+	}
+
+	model0 := yaml.Model{
+		Name: "Basic",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+				Attributes: []string{
+					"mandatory",
+				},
+			},
+			"Code": {
+				Type: yaml.ModelFieldTypeString,
+				Attributes: []string{
+					"immutable",
+					"mandatory",
+				},
+			},
+			"Note": {
+				Type: yaml.ModelFieldTypeString,
+			},
+			"Sequence": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"BasicParent": {
+				Type: "ForOne",
+			},
+		},
+	}
+	model1 := yaml.Model{
+		Name: "BasicParent",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+	}
+	r := registry.NewRegistry()
+	r.SetModel("Basic", model0)
+	r.SetModel("BasicParent", model1)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 4)
+
+	suite.Equal(allTsObjects[0].Name, "Basic")
+	suite.Equal(allTsObjects[1].Name, "BasicIDPrimary")
+
+	nullableString := tsdef.TsTypeUnion{
+		Types: []tsdef.TsType{
+			tsdef.TsTypeString,
+			tsdef.TsTypeNull,
+		},
+	}
+	parentIDField := tsdef.ObjectField{
+		Name: "basicParentID",
+		Type: tsdef.TsTypeOptional{
+			ValueType: tsdef.TsTypeNumber,
+		},
+		Docs: []string{"`ForOne` relation to `BasicParent`"},
+	}
+
+	createObject := allTsObjects[2]
+	suite.Equal(createObject.Name, "BasicCreate")
+	suite.Nil(createObject.Imports)
+	suite.Equal(createObject.Fields, []tsdef.ObjectField{
+		{
+			Name: "code",
+			Type: tsdef.TsTypeString,
+			Docs: []string{"Attributes: `immutable`, `mandatory`"},
+		},
+		{
+			Name: "note",
+			Type: tsdef.TsTypeOptional{
+				ValueType: nullableString,
+			},
+		},
+		parentIDField,
+	})
+
+	updateObject := allTsObjects[3]
+	suite.Equal(updateObject.Name, "BasicUpdate")
+	suite.Equal(updateObject.Fields, []tsdef.ObjectField{
+		{
+			Name: "id",
+			Type: tsdef.TsTypeNumber,
+			Docs: []string{"Attributes: `mandatory`"},
+		},
+		{
+			Name: "note",
+			Type: tsdef.TsTypeOptional{
+				ValueType: nullableString,
+			},
+		},
+		parentIDField,
+	})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_DiscriminatedUnions() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		DiscriminatedUnions: true,
+	}
+
+	postModel := yaml.Model{
+		Name: "Post",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeUUID,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	}
+	articleModel := yaml.Model{
+		Name: "Article",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	}
+	commentModel := yaml.Model{
+		Name: "Comment",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Commentable": {
+				Type: "ForOnePoly",
+				For:  []string{"Post", "Article"},
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("Post", postModel)
+	r.SetModel("Article", articleModel)
+	r.SetModel("Comment", commentModel)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, commentModel)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 5)
+
+	commentObject := allTsObjects[0]
+	suite.Equal(commentObject.Name, "Comment")
+	suite.Equal(commentObject.Fields[1], tsdef.ObjectField{
+		Name: "commentableID",
+		Type: tsdef.TsTypeOptional{
+			ValueType: tsdef.TsTypeUnion{
+				Types: []tsdef.TsType{
+					tsdef.TsTypeString,
+					tsdef.TsTypeNumber,
+				},
+			},
+		},
+		Docs: []string{"`ForOnePoly` relation for `Post`, `Article`"},
+	})
+
+	suite.Equal(allTsObjects[1].Name, "CommentIDPrimary")
+
+	postMemberObject := allTsObjects[2]
+	suite.Equal(postMemberObject.Name, "CommentCommentablePost")
+	suite.Equal(postMemberObject.Fields, []tsdef.ObjectField{
+		{
+			Name: "type",
+			Type: tsdef.TsTypeStringLiteral{Value: "Post"},
+		},
+		{
+			Name: "value",
+			Type: tsdef.TsTypeObject{
+				ModulePath: "./post",
+				Name:       "Post",
+			},
+		},
+	})
+
+	suite.Equal(allTsObjects[3].Name, "CommentCommentableArticle")
+
+	unionObject := allTsObjects[4]
+	suite.Equal(unionObject.Name, "CommentCommentable")
+	suite.Equal(unionObject.Alias, tsdef.TsTypeUnion{
+		Types: []tsdef.TsType{
+			tsdef.TsTypeObject{Name: "CommentCommentablePost"},
+			tsdef.TsTypeObject{Name: "CommentCommentableArticle"},
+		},
+	})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_Docs() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		SourceFileNames: map[string]string{
+			"BlogPost": "posts.mod",
+		},
+	}
+
+	commentModel := yaml.Model{
+		Name: "Comment",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Commentable": {
+				Type: "ForOnePoly",
+				For:  []string{"BlogPost"},
+			},
+		},
+	}
+
+	contactModel := yaml.Model{
+		Name: "Contact",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+	}
+
+	blogPostModel := yaml.Model{
+		Name: "BlogPost",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+				Attributes: []string{
+					"immutable",
+					"mandatory",
+				},
+			},
+			"Title": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{"ID"},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{
+			"Author": {
+				Type:    "ForOne",
+				Aliased: "Contact",
+			},
+			"Note": {
+				Type:    "HasManyPoly",
+				Aliased: "Comment",
+				Through: "Commentable",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetModel("Comment", commentModel)
+	r.SetModel("Contact", contactModel)
+	r.SetModel("BlogPost", blogPostModel)
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, blogPostModel)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 2)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Docs, []string{"Morphe model `BlogPost` from `posts.mod`"})
+	suite.Len(tsObject0.Fields, 6)
+
+	suite.Equal(tsObject0.Fields[0].Name, "id")
+	suite.Equal(tsObject0.Fields[0].Docs, []string{"Attributes: `immutable`, `mandatory`"})
+
+	suite.Equal(tsObject0.Fields[1].Name, "title")
+	suite.Nil(tsObject0.Fields[1].Docs)
+
+	suite.Equal(tsObject0.Fields[2].Name, "authorID")
+	suite.Equal(tsObject0.Fields[2].Docs, []string{"`ForOne` relation to `Contact`"})
+	suite.Equal(tsObject0.Fields[3].Name, "author")
+	suite.Equal(tsObject0.Fields[3].Docs, []string{"`ForOne` relation to `Contact`"})
+
+	suite.Equal(tsObject0.Fields[4].Name, "noteIDs")
+	suite.Equal(tsObject0.Fields[4].Docs, []string{"`HasManyPoly` relation to `Comment` through `Commentable`"})
+	suite.Equal(tsObject0.Fields[5].Name, "notes")
+	suite.Equal(tsObject0.Fields[5].Docs, []string{"`HasManyPoly` relation to `Comment` through `Commentable`"})
+
+	tsObject1 := allTsObjects[1]
+	suite.Equal(tsObject1.Name, "BlogPostIDPrimary")
+	suite.Equal(tsObject1.Fields[0].Docs, []string{"Attributes: `immutable`, `mandatory`"})
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_FieldNameCollision() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{}
+
+	model0 := yaml.Model{
+		Name: "Person",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"FirstName": {
+				Type: yaml.ModelFieldTypeString,
+			},
+			"first_name": {
+				Type: yaml.ModelFieldTypeString,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.ErrorContains(allTsObjectsErr, "morphe model 'Person' fields 'FirstName', 'first_name' all compile to field name 'firstName' of 'Person'")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_InvalidFieldName() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{}
+
+	model0 := yaml.Model{
+		Name: "Account",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"2FA": {
+				Type: yaml.ModelFieldTypeBoolean,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.ErrorContains(allTsObjectsErr, "morphe model 'Account' field '2FA' compiles to field name '2FA' of 'Account', which is not a valid typescript identifier name")
+	suite.Nil(allTsObjects)
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_InvalidFieldName_Quote() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingQuote,
+		InputTypes:         true,
+	}
+
+	model0 := yaml.Model{
+		Name: "Account",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"2FA": {
+				Type: yaml.ModelFieldTypeBoolean,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 4)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Account")
+	suite.Len(tsObject0.Fields, 2)
+	suite.Equal(tsObject0.Fields[0].Name, "2FA")
+	suite.Equal(tsObject0.Fields[1].Name, "id")
+
+	tsObject2 := allTsObjects[2]
+	suite.Equal(tsObject2.Name, "AccountCreate")
+	suite.Len(tsObject2.Fields, 1)
+	suite.Equal(tsObject2.Fields[0].Name, "2FA")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_InvalidFieldName_Rename() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingRename,
+		InputTypes:         true,
+	}
+
+	model0 := yaml.Model{
+		Name: "Account",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+			"2FA": {
+				Type: yaml.ModelFieldTypeBoolean,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.Nil(allTsObjectsErr)
+	suite.Len(allTsObjects, 4)
+
+	tsObject0 := allTsObjects[0]
+	suite.Equal(tsObject0.Name, "Account")
+	suite.Len(tsObject0.Fields, 2)
+	suite.Equal(tsObject0.Fields[0].Name, "$2fa")
+	suite.Equal(tsObject0.Fields[1].Name, "id")
+
+	tsObject2 := allTsObjects[2]
+	suite.Equal(tsObject2.Name, "AccountCreate")
+	suite.Len(tsObject2.Fields, 1)
+	suite.Equal(tsObject2.Fields[0].Name, "$2fa")
+
+	tsObject3 := allTsObjects[3]
+	suite.Equal(tsObject3.Name, "AccountUpdate")
+	suite.Len(tsObject3.Fields, 2)
+	suite.Equal(tsObject3.Fields[0].Name, "$2fa")
+}
+
+func (suite *CompileModelsTestSuite) TestMorpheModelToTsObjects_InvalidTypeName() {
+	modelHooks := hook.CompileMorpheModel{}
+
+	modelsConfig := cfg.MorpheModelsConfig{
+		IdentifierHandling: cfg.IdentifierHandlingRename,
+	}
+
+	model0 := yaml.Model{
+		Name: "class",
+		Fields: map[string]yaml.ModelField{
+			"ID": {
+				Type: yaml.ModelFieldTypeAutoIncrement,
+			},
+		},
+		Identifiers: map[string]yaml.ModelIdentifier{
+			"primary": {
+				Fields: []string{
+					"ID",
+				},
+			},
+		},
+		Related: map[string]yaml.ModelRelation{},
+	}
+
+	r := registry.NewRegistry()
+
+	allTsObjects, allTsObjectsErr := compile.MorpheModelToTsObjects(modelHooks, modelsConfig, cfg.OutputLayout{}, r, model0)
+
+	suite.ErrorContains(allTsObjectsErr, "morphe model 'class' compiles to type name 'class', which is not a valid typescript identifier")
+	suite.Nil(allTsObjects)
+}
@@ -0,0 +1,85 @@
+package compile
+
+import (
+	"path/filepath"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+)
+
+// validateOutputLayoutWriters checks that the writers write each Morphe kind into the directory named by the output
+// layout, since the compiled imports and the index files link the kinds through it. Custom writers are not checked.
+func validateOutputLayoutWriters(config MorpheCompileConfig) error {
+	layout := config.OutputLayout
+	if layoutErr := layout.Validate(); layoutErr != nil {
+		return layoutErr
+	}
+
+	allKindDirNames := []struct {
+		kind           string
+		layoutDirName  string
+		writerDirNames []string
+	}{
+		{"enums", layout.GetEnumsDirName(), getAllEnumWriterDirNames(config.EnumWriter)},
+		{"models", layout.GetModelsDirName(), getAllObjectWriterDirNames(config.ModelWriter)},
+		{"structures", layout.GetStructuresDirName(), getAllObjectWriterDirNames(config.StructureWriter)},
+		{"entities", layout.GetEntitiesDirName(), getAllObjectWriterDirNames(config.EntityWriter)},
+	}
+	for _, kindDirNames := range allKindDirNames {
+		for _, writerDirName := range kindDirNames.writerDirNames {
+			if writerDirName != kindDirNames.layoutDirName {
+				return ErrOutputLayoutWriterDirName(kindDirNames.kind, writerDirName, kindDirNames.layoutDirName)
+			}
+		}
+	}
+	return nil
+}
+
+func getAllEnumWriterDirNames(writer write.TsEnumWriter) []string {
+	switch typedWriter := writer.(type) {
+	case *MorpheEnumFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *ZodEnumFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *JsonSchemaEnumFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *TypeGuardEnumFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *WireFormatEnumFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *EnumHelpersFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *BundleEnumFileWriter:
+		return []string{typedWriter.DirName}
+	case *MultiEnumWriter:
+		allDirNames := []string{}
+		for _, subWriter := range typedWriter.Writers {
+			allDirNames = append(allDirNames, getAllEnumWriterDirNames(subWriter)...)
+		}
+		return allDirNames
+	}
+	return nil
+}
+
+func getAllObjectWriterDirNames(writer write.TsObjectWriter) []string {
+	switch typedWriter := writer.(type) {
+	case *MorpheObjectFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *ZodObjectFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *JsonSchemaObjectFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *TypeGuardObjectFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *WireFormatObjectFileWriter:
+		return []string{filepath.Base(typedWriter.TargetDirPath)}
+	case *BundleObjectFileWriter:
+		return []string{typedWriter.DirName}
+	case *MultiObjectWriter:
+		allDirNames := []string{}
+		for _, subWriter := range typedWriter.Writers {
+			allDirNames = append(allDirNames, getAllObjectWriterDirNames(subWriter)...)
+		}
+		return allDirNames
+	}
+	return nil
+}
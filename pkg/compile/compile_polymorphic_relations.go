@@ -0,0 +1,136 @@
+package compile
+
+import (
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const (
+	polymorphicUnionTypeFieldName  = "type"
+	polymorphicUnionValueFieldName = "value"
+)
+
+// polymorphicTarget is one of the models or entities listed in the `for` property of a polymorphic relation
+type polymorphicTarget struct {
+	name     string
+	typeName string
+	idType   tsdef.TsType
+}
+
+// getPolymorphicTypeTsType types the discriminator field of a polymorphic relation with the names of its targets,
+// e.g. `"Person" | "Company"`.
+func getPolymorphicTypeTsType(allTargets []polymorphicTarget) tsdef.TsType {
+	allLiteralTypes := []tsdef.TsType{}
+	for _, target := range allTargets {
+		allLiteralTypes = append(allLiteralTypes, tsdef.TsTypeStringLiteral{
+			Value: target.name,
+		})
+	}
+	return getDistinctTsTypeUnion(allLiteralTypes)
+}
+
+// getPolymorphicIDTsType types the ID field of a polymorphic relation with the primary identifier types of its targets.
+func getPolymorphicIDTsType(allTargets []polymorphicTarget) tsdef.TsType {
+	allIDTypes := []tsdef.TsType{}
+	for _, target := range allTargets {
+		allIDTypes = append(allIDTypes, target.idType)
+	}
+	return getDistinctTsTypeUnion(allIDTypes)
+}
+
+func getPolymorphicValueTsType(allTargets []polymorphicTarget) tsdef.TsTypeUnion {
+	unionTypes := []tsdef.TsType{}
+	for _, target := range allTargets {
+		unionTypes = append(unionTypes, tsdef.TsTypeObject{
+			ModulePath: "./" + strcase.ToKebabCaseLower(target.typeName),
+			Name:       target.typeName,
+		})
+	}
+	return tsdef.TsTypeUnion{
+		Types: unionTypes,
+	}
+}
+
+// getPolymorphicThroughTsType narrows the target object type of a polymorphic "Has" relationship to the records
+// pointing back at the source, e.g. `Comment & { commentableType: "Person" }`.
+func getPolymorphicThroughTsType(tsType tsdef.TsType, through string, sourceName string) tsdef.TsType {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return tsdef.TsTypeOptional{
+			ValueType: getPolymorphicThroughTsType(typedType.ValueType, through, sourceName),
+		}
+	case tsdef.TsTypeArray:
+		return tsdef.TsTypeArray{
+			ValueType: getPolymorphicThroughTsType(typedType.ValueType, through, sourceName),
+		}
+	case tsdef.TsTypeObject:
+		return tsdef.TsTypeNarrowed{
+			ValueType: typedType,
+			FieldName: strcase.ToCamelCase(through) + "Type",
+			FieldType: tsdef.TsTypeStringLiteral{
+				Value: sourceName,
+			},
+		}
+	}
+	return tsType
+}
+
+// getDistinctTsTypeUnion returns the union of all distinct types, or the type itself if all types are the same.
+func getDistinctTsTypeUnion(allTypes []tsdef.TsType) tsdef.TsType {
+	allSeenSyntaxes := map[string]bool{}
+	distinctUnion := tsdef.TsTypeUnion{}
+	for _, tsType := range allTypes {
+		typeSyntax := tsType.GetSyntax()
+		if allSeenSyntaxes[typeSyntax] {
+			continue
+		}
+		allSeenSyntaxes[typeSyntax] = true
+		distinctUnion.Types = append(distinctUnion.Types, tsType)
+	}
+	if len(distinctUnion.Types) == 1 {
+		return distinctUnion.Types[0]
+	}
+	return distinctUnion
+}
+
+// getPolymorphicUnionObjectTypes returns the `CommentCommentable` discriminated union of a polymorphic relation, which
+// is an alias of one `{ type: "Person"; value: Person }` member type per target.
+//
+// The member types are returned before the union, all referencing each other from the same file.
+func getPolymorphicUnionObjectTypes(objectTypeName string, relationshipName string, allTargets []polymorphicTarget) []*tsdef.Object {
+	unionTypeName := objectTypeName + strcase.ToPascalCase(relationshipName)
+	unionType := tsdef.TsTypeUnion{}
+
+	allUnionObjectTypes := []*tsdef.Object{}
+	for _, target := range allTargets {
+		memberTypeName := unionTypeName + strcase.ToPascalCase(target.name)
+		memberType := tsdef.Object{
+			Name: memberTypeName,
+			Fields: []tsdef.ObjectField{
+				{
+					Name: polymorphicUnionTypeFieldName,
+					Type: tsdef.TsTypeStringLiteral{
+						Value: target.name,
+					},
+				},
+				{
+					Name: polymorphicUnionValueFieldName,
+					Type: tsdef.TsTypeObject{
+						ModulePath: "./" + strcase.ToKebabCaseLower(target.typeName),
+						Name:       target.typeName,
+					},
+				},
+			},
+		}
+		allUnionObjectTypes = append(allUnionObjectTypes, &memberType)
+		unionType.Types = append(unionType.Types, tsdef.TsTypeObject{
+			Name: memberTypeName,
+		})
+	}
+
+	allUnionObjectTypes = append(allUnionObjectTypes, &tsdef.Object{
+		Name:  unionTypeName,
+		Alias: unionType,
+	})
+	return allUnionObjectTypes
+}
@@ -0,0 +1,36 @@
+package compile
+
+import (
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/morphe-go/pkg/yamlfile"
+)
+
+// morpheDefinitionName reads only the name of a registry definition file, which the registry keys definitions by.
+type morpheDefinitionName struct {
+	Name string `yaml:"name"`
+}
+
+// getMorpheSourceFileNames loads the file names of all definitions in a registry directory by definition name.
+func getMorpheSourceFileNames(dirPath string, fileSuffix string) (map[string]string, error) {
+	allDefinitionNames, loadErr := yamlfile.UnmarshalAllYAMLFiles[morpheDefinitionName](dirPath, fileSuffix)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	allSourceFileNames := map[string]string{}
+	for _, filePath := range core.MapKeysSorted(allDefinitionNames) {
+		allSourceFileNames[allDefinitionNames[filePath].Name] = filepath.Base(filePath)
+	}
+	return allSourceFileNames, nil
+}
+
+// getMorpheSourceFileNamesOrLoad returns the configured source file names, or loads them from the registry directory if
+// none were provided.
+func getMorpheSourceFileNamesOrLoad(allSourceFileNames map[string]string, dirPath string, fileSuffix string) (map[string]string, error) {
+	if allSourceFileNames != nil || dirPath == "" {
+		return allSourceFileNames, nil
+	}
+	return getMorpheSourceFileNames(dirPath, fileSuffix)
+}
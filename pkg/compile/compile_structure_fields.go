@@ -2,46 +2,84 @@ package compile
 
 import (
 	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
 
 	"github.com/kalo-build/morphe-go/pkg/registry"
 	"github.com/kalo-build/morphe-go/pkg/yaml"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
-	"github.com/kalo-build/plugin-morphe-ts-types/pkg/typemap"
 )
 
-func getTsFieldsForMorpheStructure(r *registry.Registry, structureFields map[string]yaml.StructureField) ([]tsdef.ObjectField, error) {
+func getTsFieldsForMorpheStructure(config morpheStructureCompileConfig, r *registry.Registry, structure yaml.Structure) ([]tsdef.ObjectField, error) {
 	if r == nil {
 		return nil, ErrNoRegistry
 	}
 
 	allFields := []tsdef.ObjectField{}
-	allFieldNames := core.MapKeysSorted(structureFields)
+	allFieldNames := core.MapKeysSorted(structure.Fields)
 	for _, fieldName := range allFieldNames {
-		field := structureFields[fieldName]
-		fieldType, fieldTypeErr := getTsTypeForStructureField(r.GetAllEnums(), field)
+		field := structure.Fields[fieldName]
+		fieldType, fieldTypeErr := getTsTypeForStructureField(config, r, structure.Name, field)
 		if fieldTypeErr != nil {
 			return nil, fieldTypeErr
 		}
 
 		allFields = append(allFields, tsdef.ObjectField{
-			Name: fieldName,
-			Type: fieldType,
+			Name:     fieldName,
+			Type:     getTsTypeWithFieldList(field.Attributes, fieldType),
+			Docs:     getMorpheFieldDocs(field.Attributes),
+			DateOnly: field.Type == yaml.StructureFieldTypeDate,
 		})
 	}
 
 	return allFields, nil
 }
 
-func getTsTypeForStructureField(allEnums map[string]yaml.Enum, field yaml.StructureField) (tsdef.TsType, error) {
-	tsEnumType := getEnumFieldAsTsFieldType(allEnums, "", string(field.Type))
+func getTsTypeForStructureField(config morpheStructureCompileConfig, r *registry.Registry, structureName string, field yaml.StructureField) (tsdef.TsType, error) {
+	tsEnumType := getEnumFieldAsTsFieldType(config.OutputLayout.GetEnumsDirName(), r.GetAllEnums(), "", string(field.Type))
 	if tsEnumType.Type != nil {
 		return tsEnumType.Type, nil
 	}
 
-	tsType, tsTypeExists := typemap.MorpheStructureFieldToTsField[field.Type]
-	if !tsTypeExists {
-		return nil, ErrUnsupportedMorpheFieldType(field.Type)
+	tsStructureType, isStructure := getStructureFieldAsTsType(r.GetAllStructures(), structureName, string(field.Type))
+	if isStructure {
+		return tsStructureType, nil
 	}
 
-	return tsType, nil
+	return getTsTypeForMorpheStructureFieldType(config.TypeMappings, field.Type)
+}
+
+// getStructureFieldAsTsType references another structure from a sibling structure file, or from the same file if the
+// structure references itself.
+func getStructureFieldAsTsType(allStructures map[string]yaml.Structure, structureName string, fieldStructureName string) (tsdef.TsTypeObject, bool) {
+	if fieldStructureName == structureName {
+		return tsdef.TsTypeObject{
+			Name: fieldStructureName,
+		}, true
+	}
+	return getStructureAsTsType(".", allStructures, fieldStructureName)
+}
+
+// getStructureAsTsType references a structure from the given structures directory path, if the type is a structure.
+func getStructureAsTsType(structuresDirPath string, allStructures map[string]yaml.Structure, structureName string) (tsdef.TsTypeObject, bool) {
+	_, structureTypeExists := allStructures[structureName]
+	if !structureTypeExists {
+		return tsdef.TsTypeObject{}, false
+	}
+	return tsdef.TsTypeObject{
+		ModulePath: structuresDirPath + "/" + strcase.ToKebabCaseLower(structureName),
+		Name:       structureName,
+	}, true
+}
+
+// getMorpheFieldTypeValidationEnums returns the types non-primitive Morphe field types are validated against.
+//
+// Morphe only validates non-primitive field types against enums, so structures are added as empty placeholder enums.
+func getMorpheFieldTypeValidationEnums(r *registry.Registry) map[string]yaml.Enum {
+	allValidationEnums := r.GetAllEnums()
+	for structureName := range r.GetAllStructures() {
+		if _, isEnum := allValidationEnums[structureName]; !isEnum {
+			allValidationEnums[structureName] = yaml.Enum{}
+		}
+	}
+	return allValidationEnums
 }
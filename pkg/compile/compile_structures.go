@@ -9,9 +9,16 @@ import (
 )
 
 func AllMorpheStructuresToTsObjects(config MorpheCompileConfig, r *registry.Registry) (map[string]*tsdef.Object, error) {
+	structuresConfig := config.MorpheStructuresConfig
+	allSourceFileNames, sourceFileNamesErr := getMorpheSourceFileNamesOrLoad(structuresConfig.SourceFileNames, config.RegistryStructuresDirPath, registry.StructureFileSuffix)
+	if sourceFileNamesErr != nil {
+		return nil, sourceFileNamesErr
+	}
+	structuresConfig.SourceFileNames = allSourceFileNames
+
 	allStructureTypeDefs := map[string]*tsdef.Object{}
 	for structureName, structure := range r.GetAllStructures() {
-		structureType, structureErr := MorpheStructureToTsObject(config.StructureHooks, config.MorpheStructuresConfig, r, structure)
+		structureType, structureErr := MorpheStructureToTsObject(config.StructureHooks, structuresConfig, config.OutputLayout, r, structure)
 		if structureErr != nil {
 			return nil, structureErr
 		}
@@ -20,7 +27,13 @@ func AllMorpheStructuresToTsObjects(config MorpheCompileConfig, r *registry.Regi
 	return allStructureTypeDefs, nil
 }
 
-func MorpheStructureToTsObject(structureHooks hook.CompileMorpheStructure, config cfg.MorpheStructuresConfig, r *registry.Registry, structure yaml.Structure) (*tsdef.Object, error) {
+// morpheStructureCompileConfig is the structures config alongside the output layout the sibling enums are imported from.
+type morpheStructureCompileConfig struct {
+	cfg.MorpheStructuresConfig
+	OutputLayout cfg.OutputLayout
+}
+
+func MorpheStructureToTsObject(structureHooks hook.CompileMorpheStructure, config cfg.MorpheStructuresConfig, outputLayout cfg.OutputLayout, r *registry.Registry, structure yaml.Structure) (*tsdef.Object, error) {
 	if r == nil {
 		return nil, triggerCompileMorpheStructureFailure(structureHooks, config, structure, ErrNoRegistry)
 	}
@@ -29,7 +42,11 @@ func MorpheStructureToTsObject(structureHooks hook.CompileMorpheStructure, confi
 		return nil, triggerCompileMorpheStructureFailure(structureHooks, config, structure, compileStartErr)
 	}
 
-	structureType, objectErr := morpheStructureToTsObjectType(config, r, structure)
+	compileConfig := morpheStructureCompileConfig{
+		MorpheStructuresConfig: config,
+		OutputLayout:           outputLayout,
+	}
+	structureType, objectErr := morpheStructureToTsObjectType(compileConfig, r, structure)
 	if objectErr != nil {
 		return nil, triggerCompileMorpheStructureFailure(structureHooks, config, structure, objectErr)
 	}
@@ -41,21 +58,26 @@ func MorpheStructureToTsObject(structureHooks hook.CompileMorpheStructure, confi
 	return structureType, nil
 }
 
-func morpheStructureToTsObjectType(config cfg.MorpheStructuresConfig, r *registry.Registry, structure yaml.Structure) (*tsdef.Object, error) {
+func morpheStructureToTsObjectType(config morpheStructureCompileConfig, r *registry.Registry, structure yaml.Structure) (*tsdef.Object, error) {
 	validateConfigErr := config.Validate()
 	if validateConfigErr != nil {
 		return nil, validateConfigErr
 	}
-	validateMorpheErr := structure.Validate(r.GetAllEnums())
+	validateLayoutErr := config.OutputLayout.Validate()
+	if validateLayoutErr != nil {
+		return nil, validateLayoutErr
+	}
+	validateMorpheErr := structure.Validate(getMorpheFieldTypeValidationEnums(r))
 	if validateMorpheErr != nil {
 		return nil, validateMorpheErr
 	}
 
 	structureType := tsdef.Object{
 		Name: structure.Name,
+		Docs: getMorpheStructureSourceDocs(config.MorpheStructuresConfig, structure.Name),
 	}
 
-	typeFields, fieldsErr := getTsFieldsForMorpheStructure(r, structure.Fields)
+	typeFields, fieldsErr := getTsFieldsForMorpheStructure(config, r, structure)
 	if fieldsErr != nil {
 		return nil, fieldsErr
 	}
@@ -67,6 +89,10 @@ func morpheStructureToTsObjectType(config cfg.MorpheStructuresConfig, r *registr
 	}
 	structureType.Imports = objectImports
 
+	identifiersErr := validateTsObjectIdentifiers(config.IdentifierHandling, getMorpheStructureIdentifierSource(structure), []*tsdef.Object{&structureType})
+	if identifiersErr != nil {
+		return nil, identifiersErr
+	}
 	return &structureType, nil
 }
 
@@ -45,7 +45,7 @@ func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject() {
 
 	r := registry.NewRegistry()
 
-	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, r, structure0)
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, structure0)
 
 	suite.Nil(tsObjectErr)
 	suite.NotNil(tsObject)
@@ -107,7 +107,7 @@ func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject_StartHook
 
 	r := registry.NewRegistry()
 
-	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, r, structure0)
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, structure0)
 
 	suite.Nil(tsObjectErr)
 	suite.NotNil(tsObject)
@@ -154,8 +154,166 @@ func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject_StartHook
 
 	r := registry.NewRegistry()
 
-	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, r, structure0)
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, structure0)
 
 	suite.ErrorContains(tsObjectErr, "compile structure start hook error")
 	suite.Nil(tsObject)
 }
+
+func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject_TypeMappings() {
+	structureHooks := hook.CompileMorpheStructure{}
+	structuresConfig := cfg.MorpheStructuresConfig{
+		TypeMappings: cfg.TypeMappings{
+			"Time": {TypeName: "string"},
+		},
+	}
+
+	structure0 := yaml.Structure{
+		Name: "Period",
+		Fields: map[string]yaml.StructureField{
+			"Start": {
+				Type: yaml.StructureFieldTypeTime,
+			},
+			"Date": {
+				Type: yaml.StructureFieldTypeDate,
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, structure0)
+
+	suite.Nil(tsObjectErr)
+	suite.NotNil(tsObject)
+
+	suite.Equal(tsObject.Fields, []tsdef.ObjectField{
+		{
+			Name:     "Date",
+			Type:     tsdef.TsTypeDate,
+			DateOnly: true,
+		},
+		{
+			Name: "Start",
+			Type: tsdef.TsTypeString,
+		},
+	})
+}
+
+func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject_Structures() {
+	structureHooks := hook.CompileMorpheStructure{}
+	structuresConfig := cfg.MorpheStructuresConfig{}
+
+	addressStructure := yaml.Structure{
+		Name: "Address",
+		Fields: map[string]yaml.StructureField{
+			"Street": {
+				Type: yaml.StructureFieldTypeString,
+			},
+		},
+	}
+	shipmentStructure := yaml.Structure{
+		Name: "Shipment",
+		Fields: map[string]yaml.StructureField{
+			"Origin": {
+				Type: "Address",
+			},
+			"Stops": {
+				Type:       "Address",
+				Attributes: []string{"list"},
+			},
+			"Nationalities": {
+				Type:       "Nationality",
+				Attributes: []string{"list"},
+			},
+			"ReturnShipment": {
+				Type: "Shipment",
+			},
+		},
+	}
+	nationalityEnum := yaml.Enum{
+		Name: "Nationality",
+		Type: yaml.EnumTypeString,
+		Entries: map[string]any{
+			"US": "American",
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetEnum("Nationality", nationalityEnum)
+	r.SetStructure("Address", addressStructure)
+	r.SetStructure("Shipment", shipmentStructure)
+
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, shipmentStructure)
+
+	suite.Nil(tsObjectErr)
+	suite.NotNil(tsObject)
+
+	suite.Equal(tsObject.Fields, []tsdef.ObjectField{
+		{
+			Name: "Nationalities",
+			Type: tsdef.TsTypeArray{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "../enums/nationality",
+					Name:       "Nationality",
+				},
+			},
+			Docs: []string{"Attributes: `list`"},
+		},
+		{
+			Name: "Origin",
+			Type: tsdef.TsTypeObject{
+				ModulePath: "./address",
+				Name:       "Address",
+			},
+		},
+		{
+			Name: "ReturnShipment",
+			Type: tsdef.TsTypeObject{
+				Name: "Shipment",
+			},
+		},
+		{
+			Name: "Stops",
+			Type: tsdef.TsTypeArray{
+				ValueType: tsdef.TsTypeObject{
+					ModulePath: "./address",
+					Name:       "Address",
+				},
+			},
+			Docs: []string{"Attributes: `list`"},
+		},
+	})
+	suite.Equal(tsObject.Imports, []tsdef.ObjectImport{
+		{
+			ModuleNames: []string{"Nationality"},
+			ModulePath:  "../enums/nationality",
+		},
+		{
+			ModuleNames: []string{"Address"},
+			ModulePath:  "./address",
+		},
+	})
+}
+
+func (suite *CompileStructuresTestSuite) TestMorpheStructureToTsObject_UnknownFieldType() {
+	structureHooks := hook.CompileMorpheStructure{}
+	structuresConfig := cfg.MorpheStructuresConfig{}
+
+	structure0 := yaml.Structure{
+		Name: "Shipment",
+		Fields: map[string]yaml.StructureField{
+			"Origin": {
+				Type: "Address",
+			},
+		},
+	}
+
+	r := registry.NewRegistry()
+	r.SetStructure("Shipment", structure0)
+
+	tsObject, tsObjectErr := compile.MorpheStructureToTsObject(structureHooks, structuresConfig, cfg.OutputLayout{}, r, structure0)
+
+	suite.ErrorContains(tsObjectErr, "morphe structure field 'Origin' has unknown non-primitive type 'Address'")
+	suite.Nil(tsObject)
+}
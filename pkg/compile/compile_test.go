@@ -1,6 +1,7 @@
 package compile_test
 
 import (
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
@@ -12,6 +13,8 @@ import (
 	"github.com/kalo-build/plugin-morphe-ts-types/internal/testutils"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
 )
 
 type CompileTestSuite struct {
@@ -76,6 +79,10 @@ func (suite *CompileTestSuite) TestMorpheToTypescript() {
 		StructureWriter: &compile.MorpheObjectFileWriter{
 			TargetDirPath: workingDirPath + "/structures",
 		},
+
+		IndexWriter: &compile.MorpheIndexFileWriter{
+			TargetDirPath: workingDirPath,
+		},
 	}
 
 	compileErr := compile.MorpheToTypescript(config)
@@ -134,6 +141,11 @@ func (suite *CompileTestSuite) TestMorpheToTypescript() {
 	suite.FileExists(structurePath0)
 	suite.FileEquals(structurePath0, gtStructurePath0)
 
+	structurePath1 := structuresDirPath + "/shipment.d.ts"
+	gtStructurePath1 := gtStructuresDirPath + "/shipment.d.ts"
+	suite.FileExists(structurePath1)
+	suite.FileEquals(structurePath1, gtStructurePath1)
+
 	entitiesDirPath := workingDirPath + "/entities"
 	gtEntitiesDirPath := suite.TestGroundTruthDirPath + "/entities"
 	suite.DirExists(entitiesDirPath)
@@ -147,4 +159,1029 @@ func (suite *CompileTestSuite) TestMorpheToTypescript() {
 	gtEntityPath1 := gtEntitiesDirPath + "/person.d.ts"
 	suite.FileExists(entityPath1)
 	suite.FileEquals(entityPath1, gtEntityPath1)
+
+	allIndexPaths := []string{
+		"index.ts",
+		"enums/index.ts",
+		"models/index.ts",
+		"structures/index.ts",
+		"entities/index.ts",
+	}
+	for _, indexPath := range allIndexPaths {
+		suite.FileExists(filepath.Join(workingDirPath, indexPath))
+		suite.FileEquals(filepath.Join(workingDirPath, indexPath), filepath.Join(suite.TestGroundTruthDirPath, indexPath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_NoIndexWriter() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter:      &compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath + "/enums"},
+		ModelWriter:     &compile.MorpheObjectFileWriter{TargetDirPath: workingDirPath + "/models"},
+		EntityWriter:    &compile.MorpheObjectFileWriter{TargetDirPath: workingDirPath + "/entities"},
+		StructureWriter: &compile.MorpheObjectFileWriter{TargetDirPath: workingDirPath + "/structures"},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+	suite.NoFileExists(filepath.Join(workingDirPath, "index.ts"))
+	suite.NoFileExists(filepath.Join(workingDirPath, "models", "index.ts"))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_NoIndexWithoutDefinitionWriters() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.EnumWriter = &compile.ZodEnumFileWriter{TargetDirPath: workingDirPath + "/enums"}
+	config.ModelWriter = &compile.ZodObjectFileWriter{TargetDirPath: workingDirPath + "/models"}
+	config.StructureWriter = &compile.ZodObjectFileWriter{TargetDirPath: workingDirPath + "/structures"}
+	config.EntityWriter = &compile.ZodObjectFileWriter{TargetDirPath: workingDirPath + "/entities"}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+	suite.FileExists(filepath.Join(workingDirPath, "models", "person.ts"))
+	suite.NoFileExists(filepath.Join(workingDirPath, "index.ts"))
+	suite.NoFileExists(filepath.Join(workingDirPath, "models", "index.ts"))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_OutputLayoutWriterMismatch() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.OutputLayout = cfg.OutputLayout{
+		ModelsDirName: "model-types",
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.ErrorContains(compileErr, "models writer writes to directory 'models', but the output layout names it 'model-types'")
+	suite.NoFileExists(filepath.Join(workingDirPath, "models", "person.d.ts"))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_Zod() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtZodDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-zod")
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.ZodEnumFileWriter{
+			TargetDirPath: workingDirPath + "/enums",
+		},
+		ModelWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/models",
+		},
+		EntityWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/entities",
+		},
+		StructureWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/structures",
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"enums/nationality.ts",
+		"enums/universal-number.ts",
+		"models/comment.ts",
+		"models/company.ts",
+		"models/contact.ts",
+		"models/contact-info.ts",
+		"models/person.ts",
+		"structures/address.ts",
+		"structures/shipment.ts",
+		"entities/company.ts",
+		"entities/person.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtZodDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_JsonSchema() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtJsonSchemaDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-json-schema")
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.JsonSchemaEnumFileWriter{
+			TargetDirPath: workingDirPath + "/enums",
+		},
+		ModelWriter: &compile.JsonSchemaObjectFileWriter{
+			TargetDirPath: workingDirPath + "/models",
+		},
+		EntityWriter: &compile.JsonSchemaObjectFileWriter{
+			TargetDirPath: workingDirPath + "/entities",
+		},
+		StructureWriter: &compile.JsonSchemaObjectFileWriter{
+			TargetDirPath: workingDirPath + "/structures",
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"enums/nationality.schema.json",
+		"enums/universal-number.schema.json",
+		"models/comment.schema.json",
+		"models/company.schema.json",
+		"models/contact.schema.json",
+		"models/contact-info.schema.json",
+		"models/person.schema.json",
+		"structures/address.schema.json",
+		"structures/shipment.schema.json",
+		"entities/company.schema.json",
+		"entities/person.schema.json",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtJsonSchemaDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_TypeGuards() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtGuardsDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-guards")
+
+	newObjectWriter := func(targetDirPath string) *compile.MultiObjectWriter {
+		return &compile.MultiObjectWriter{
+			Writers: []write.TsObjectWriter{
+				&compile.MorpheObjectFileWriter{TargetDirPath: targetDirPath},
+				&compile.TypeGuardObjectFileWriter{TargetDirPath: targetDirPath},
+			},
+		}
+	}
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.MultiEnumWriter{
+			Writers: []write.TsEnumWriter{
+				&compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath + "/enums"},
+				&compile.TypeGuardEnumFileWriter{TargetDirPath: workingDirPath + "/enums"},
+			},
+		},
+		ModelWriter:     newObjectWriter(workingDirPath + "/models"),
+		EntityWriter:    newObjectWriter(workingDirPath + "/entities"),
+		StructureWriter: newObjectWriter(workingDirPath + "/structures"),
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFileNames := []string{
+		"enums/nationality",
+		"enums/universal-number",
+		"models/comment",
+		"models/company",
+		"models/contact",
+		"models/contact-info",
+		"models/person",
+		"structures/address",
+		"structures/shipment",
+		"entities/company",
+		"entities/person",
+	}
+	for _, fileName := range allFileNames {
+		suite.FileEquals(filepath.Join(workingDirPath, fileName+".d.ts"), filepath.Join(suite.TestGroundTruthDirPath, fileName+".d.ts"))
+		suite.FileEquals(filepath.Join(workingDirPath, fileName+".guard.ts"), filepath.Join(gtGuardsDirPath, fileName+".guard.ts"))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_EnumHelpers() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtEnumHelpersDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-enum-helpers")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.EnumWriter = &compile.MultiEnumWriter{
+		Writers: []write.TsEnumWriter{
+			config.EnumWriter,
+			&compile.EnumHelpersFileWriter{
+				TargetDirPath: filepath.Join(workingDirPath, "enums"),
+			},
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"enums/nationality.helpers.ts",
+		"enums/universal-number.helpers.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtEnumHelpersDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_WireFormat() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtWireFormatDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-wire-format")
+
+	newObjectWriter := func(targetDirPath string) *compile.MultiObjectWriter {
+		return &compile.MultiObjectWriter{
+			Writers: []write.TsObjectWriter{
+				&compile.MorpheObjectFileWriter{TargetDirPath: targetDirPath},
+				&compile.WireFormatObjectFileWriter{TargetDirPath: targetDirPath},
+			},
+		}
+	}
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.MultiEnumWriter{
+			Writers: []write.TsEnumWriter{
+				&compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath + "/enums"},
+				&compile.WireFormatEnumFileWriter{TargetDirPath: workingDirPath + "/enums"},
+			},
+		},
+		ModelWriter:     newObjectWriter(workingDirPath + "/models"),
+		EntityWriter:    newObjectWriter(workingDirPath + "/entities"),
+		StructureWriter: newObjectWriter(workingDirPath + "/structures"),
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFileNames := []string{
+		"enums/nationality",
+		"enums/universal-number",
+		"models/comment",
+		"models/company",
+		"models/contact",
+		"models/contact-info",
+		"models/person",
+		"structures/address",
+		"structures/shipment",
+		"entities/company",
+		"entities/person",
+	}
+	for _, fileName := range allFileNames {
+		suite.FileEquals(filepath.Join(workingDirPath, fileName+".d.ts"), filepath.Join(suite.TestGroundTruthDirPath, fileName+".d.ts"))
+		suite.FileEquals(filepath.Join(workingDirPath, fileName+".wire.ts"), filepath.Join(gtWireFormatDirPath, fileName+".wire.ts"))
+	}
+}
+
+func (suite *CompileTestSuite) TestWireFormatObjectFileWriter_Dates() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	writer := compile.WireFormatObjectFileWriter{TargetDirPath: workingDirPath}
+	eventObject := tsdef.Object{
+		Name: "Event",
+		Fields: []tsdef.ObjectField{
+			{Name: "startsAt", Type: tsdef.TsTypeDate},
+			{Name: "endsAt", Type: tsdef.TsTypeOptional{ValueType: tsdef.TsTypeDate}},
+			{Name: "cancelledAt", Type: tsdef.TsTypeUnion{Types: []tsdef.TsType{tsdef.TsTypeDate, tsdef.TsTypeNull}}},
+			{Name: "reminders", Type: tsdef.TsTypeArray{ValueType: tsdef.TsTypeArray{ValueType: tsdef.TsTypeDate}}},
+		},
+	}
+
+	wireContents, writeErr := writer.WriteObject("Event", &eventObject)
+
+	suite.NoError(writeErr)
+	suite.Equal(`import type { Event } from "./event"
+
+export type EventJSON = {
+	startsAt: string
+	endsAt?: string
+	cancelledAt: string | null
+	reminders: string[][]
+}
+
+export function fromEventJSON(json: EventJSON): Event {
+	return {
+		startsAt: new Date(json.startsAt),
+		endsAt: (json.endsAt === undefined ? undefined : new Date(json.endsAt)),
+		cancelledAt: (json.cancelledAt === null ? null : new Date(json.cancelledAt)),
+		reminders: json.reminders.map((item) => item.map((item1) => new Date(item1))),
+	}
+}
+
+export function toEventJSON(value: Event): EventJSON {
+	return {
+		startsAt: value.startsAt.toISOString(),
+		endsAt: (value.endsAt === undefined ? undefined : value.endsAt.toISOString()),
+		cancelledAt: (value.cancelledAt === null ? null : value.cancelledAt.toISOString()),
+		reminders: value.reminders.map((item) => item.map((item1) => item1.toISOString())),
+	}
+}
+`, string(wireContents))
+	suite.FileExists(filepath.Join(workingDirPath, "event.wire.ts"))
+}
+
+func (suite *CompileTestSuite) TestJsonSchemaObjectFileWriter_DateOnly() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	eventObject := tsdef.Object{
+		Name: "Event",
+		Fields: []tsdef.ObjectField{
+			{Name: "day", Type: tsdef.TsTypeDate, DateOnly: true},
+			{Name: "holidays", Type: tsdef.TsTypeArray{ValueType: tsdef.TsTypeDate}, DateOnly: true},
+			{Name: "startsAt", Type: tsdef.TsTypeDate},
+		},
+	}
+
+	schemaWriter := compile.JsonSchemaObjectFileWriter{TargetDirPath: workingDirPath}
+	schemaContents, writeErr := schemaWriter.WriteObject("Event", &eventObject)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(schemaContents), `"day": {
+					"format": "date",
+					"type": "string"
+				}`)
+	suite.Contains(string(schemaContents), `"holidays": {
+					"items": {
+						"format": "date",
+						"type": "string"
+					},
+					"type": "array"
+				}`)
+	suite.Contains(string(schemaContents), `"startsAt": {
+					"format": "date-time",
+					"type": "string"
+				}`)
+}
+
+func (suite *CompileTestSuite) TestWireFormatObjectFileWriter_PolymorphicDates() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	writer := compile.WireFormatObjectFileWriter{TargetDirPath: workingDirPath}
+	eventObject := tsdef.Object{
+		Name: "Event",
+		Fields: []tsdef.ObjectField{
+			{Name: "startsAt", Type: tsdef.TsTypeDate},
+		},
+	}
+	attachableType := tsdef.TsTypeUnion{
+		Types: []tsdef.TsType{
+			tsdef.TsTypeObject{ModulePath: "./event", Name: "Event"},
+			tsdef.TsTypeObject{ModulePath: "./person", Name: "Person"},
+		},
+	}
+	attachmentObject := tsdef.Object{
+		Name: "Attachment",
+		Fields: []tsdef.ObjectField{
+			{Name: "attachableType", Type: tsdef.TsTypeOptional{ValueType: tsdef.TsTypeUnion{Types: []tsdef.TsType{
+				tsdef.TsTypeStringLiteral{Value: "Event"},
+				tsdef.TsTypeStringLiteral{Value: "Person"},
+			}}}},
+			{Name: "attachable", Type: tsdef.TsTypeOptional{ValueType: attachableType}},
+			{Name: "attachables", Type: tsdef.TsTypeArray{ValueType: attachableType}},
+		},
+		Imports: []tsdef.ObjectImport{
+			{ModulePath: "./event", ModuleNames: []string{"Event"}},
+			{ModulePath: "./person", ModuleNames: []string{"Person"}},
+		},
+	}
+
+	eventContents, writeErr := writer.WriteObject("Event", &eventObject)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(eventContents), "\t\tstartsAt: new Date(json.startsAt),\n")
+
+	attachmentContents, writeErr := writer.WriteObject("Attachment", &attachmentObject)
+
+	suite.NoError(writeErr)
+	suite.Equal(`import type { Attachment } from "./attachment"
+import type { Event } from "./event"
+import type { Person } from "./person"
+import { type EventJSON, fromEventJSON, toEventJSON } from "./event.wire"
+import { type PersonJSON, fromPersonJSON, toPersonJSON } from "./person.wire"
+
+export type AttachmentJSON = {
+	attachableType?: "Event" | "Person"
+	attachable?: EventJSON | PersonJSON
+	attachables: (EventJSON | PersonJSON)[]
+}
+
+export function fromAttachmentJSON(json: AttachmentJSON): Attachment {
+	return {
+		attachableType: json.attachableType,
+		attachable: (json.attachable === undefined ? undefined : (json.attachableType === "Event" ? fromEventJSON(json.attachable as EventJSON) : fromPersonJSON(json.attachable as PersonJSON))),
+		attachables: json.attachables.map((item) => (json.attachableType === "Event" ? fromEventJSON(item as EventJSON) : fromPersonJSON(item as PersonJSON))),
+	}
+}
+
+export function toAttachmentJSON(value: Attachment): AttachmentJSON {
+	return {
+		attachableType: value.attachableType,
+		attachable: (value.attachable === undefined ? undefined : (value.attachableType === "Event" ? toEventJSON(value.attachable as Event) : toPersonJSON(value.attachable as Person))),
+		attachables: value.attachables.map((item) => (value.attachableType === "Event" ? toEventJSON(item as Event) : toPersonJSON(item as Person))),
+	}
+}
+`, string(attachmentContents))
+}
+
+func (suite *CompileTestSuite) TestWireFormatObjectFileWriter_NoDiscriminator() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	writer := compile.WireFormatObjectFileWriter{TargetDirPath: workingDirPath}
+	attachmentObject := tsdef.Object{
+		Name: "Attachment",
+		Fields: []tsdef.ObjectField{
+			{Name: "attachable", Type: tsdef.TsTypeUnion{Types: []tsdef.TsType{
+				tsdef.TsTypeObject{ModulePath: "./event", Name: "Event"},
+				tsdef.TsTypeObject{ModulePath: "./person", Name: "Person"},
+			}}},
+		},
+	}
+
+	attachmentContents, writeErr := writer.WriteObject("Attachment", &attachmentObject)
+
+	suite.ErrorContains(writeErr, "no discriminator to convert union 'Event | Person' from and to its wire format")
+	suite.Nil(attachmentContents)
+	suite.NoFileExists(filepath.Join(workingDirPath, "attachment.wire.ts"))
+}
+
+func (suite *CompileTestSuite) TestMorpheEnumFileWriter_Literals() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	writer := compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath}
+	stringEnum := tsdef.Enum{
+		Name: "Label",
+		Type: tsdef.TsTypeString,
+		Entries: []tsdef.EnumEntry{
+			{Name: "Quote", Value: "O'Brien"},
+			{Name: "Path", Value: `C:\temp`},
+			{Name: "Multiline", Value: "first\nsecond"},
+			{Name: "Unicode", Value: "Ünïcødé ✓"},
+			{Name: "Separator", Value: "a\u2028b"},
+		},
+	}
+
+	stringContents, writeErr := writer.WriteEnum("Label", &stringEnum)
+
+	suite.NoError(writeErr)
+	suite.Equal(`export enum Label {
+	Quote = 'O\'Brien',
+	Path = 'C:\\temp',
+	Multiline = 'first\nsecond',
+	Unicode = 'Ünïcødé ✓',
+	Separator = 'a\u2028b'
+}
+`, string(stringContents))
+
+	numberEnum := tsdef.Enum{
+		Name: "Measure",
+		Type: tsdef.TsTypeNumber,
+		Entries: []tsdef.EnumEntry{
+			{Name: "Large", Value: 1e21},
+			{Name: "Small", Value: 1.5e-7},
+			{Name: "Negative", Value: -1},
+			{Name: "MaxInt", Value: int64(9007199254740991)},
+		},
+	}
+
+	numberContents, writeErr := writer.WriteEnum("Measure", &numberEnum)
+
+	suite.NoError(writeErr)
+	suite.Equal(`export enum Measure {
+	Large = 1000000000000000000000,
+	Small = 0.00000015,
+	Negative = -1,
+	MaxInt = 9007199254740991
+}
+`, string(numberContents))
+
+	helpersWriter := compile.EnumHelpersFileWriter{TargetDirPath: workingDirPath}
+	helpersContents, writeErr := helpersWriter.WriteEnum("Measure", &numberEnum)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(helpersContents), "\t[-1]: 'Negative',\n")
+}
+
+func (suite *CompileTestSuite) TestMorpheEnumFileWriter_NonFiniteLiteral() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	writer := compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath, Style: cfg.EnumStyleUnion}
+	numberEnum := tsdef.Enum{
+		Name: "Measure",
+		Type: tsdef.TsTypeNumber,
+		Entries: []tsdef.EnumEntry{
+			{Name: "Unbounded", Value: math.Inf(1)},
+		},
+	}
+
+	numberContents, writeErr := writer.WriteEnum("Measure", &numberEnum)
+
+	suite.ErrorContains(writeErr, "invalid value of morphe enum entry 'Measure.Unbounded': typescript number literal must be finite, got '+Inf'")
+	suite.Nil(numberContents)
+	suite.NoFileExists(filepath.Join(workingDirPath, "measure.d.ts"))
+}
+
+func (suite *CompileTestSuite) TestObjectFileWriters_QuotedFieldNames() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	accountObject := tsdef.Object{
+		Name: "Account",
+		Fields: []tsdef.ObjectField{
+			{Name: "2FA", Type: tsdef.TsTypeDate},
+			{Name: "id", Type: tsdef.TsTypeNumber},
+		},
+	}
+
+	objectWriter := compile.MorpheObjectFileWriter{TargetDirPath: workingDirPath}
+	objectContents, writeErr := objectWriter.WriteObject("Account", &accountObject)
+
+	suite.NoError(writeErr)
+	suite.Equal(`export type Account = {
+	"2FA": Date
+	id: number
+}
+`, string(objectContents))
+
+	wireWriter := compile.WireFormatObjectFileWriter{TargetDirPath: workingDirPath}
+	wireContents, writeErr := wireWriter.WriteObject("Account", &accountObject)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(wireContents), "\t\t\"2FA\": new Date(json[\"2FA\"]),\n")
+	suite.Contains(string(wireContents), "\t\t\"2FA\": value[\"2FA\"].toISOString(),\n")
+
+	guardWriter := compile.TypeGuardObjectFileWriter{TargetDirPath: workingDirPath}
+	guardContents, writeErr := guardWriter.WriteObject("Account", &accountObject)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(guardContents), `record["2FA"] instanceof Date`)
+}
+
+func (suite *CompileTestSuite) TestMorpheEnumFileWriter_QuotedEntryNames() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	authMethodEnum := tsdef.Enum{
+		Name: "AuthMethod",
+		Type: tsdef.TsTypeString,
+		Entries: []tsdef.EnumEntry{
+			{Name: "2FA", Value: "two-factor"},
+			{Name: "Password", Value: "password"},
+		},
+	}
+
+	enumWriter := compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath}
+	enumContents, writeErr := enumWriter.WriteEnum("AuthMethod", &authMethodEnum)
+
+	suite.NoError(writeErr)
+	suite.Equal(`export enum AuthMethod {
+	'2FA' = 'two-factor',
+	Password = 'password'
+}
+`, string(enumContents))
+
+	constObjectWriter := compile.MorpheEnumFileWriter{TargetDirPath: workingDirPath, Style: cfg.EnumStyleConstObject}
+	constObjectContents, writeErr := constObjectWriter.WriteEnum("AuthMethod", &authMethodEnum)
+
+	suite.NoError(writeErr)
+	suite.Contains(string(constObjectContents), "\treadonly '2FA': 'two-factor'\n")
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_Bundle() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtBundleDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-bundle")
+
+	bundle := &compile.TsBundle{
+		TargetDirPath: workingDirPath,
+	}
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.BundleEnumFileWriter{
+			Bundle:          bundle,
+			DirName:         "enums",
+			CollisionSuffix: "Enum",
+		},
+		ModelWriter: &compile.BundleObjectFileWriter{
+			Bundle:          bundle,
+			DirName:         "models",
+			CollisionSuffix: "Model",
+		},
+		StructureWriter: &compile.BundleObjectFileWriter{
+			Bundle:          bundle,
+			DirName:         "structures",
+			CollisionSuffix: "Structure",
+		},
+		EntityWriter: &compile.BundleObjectFileWriter{
+			Bundle:          bundle,
+			DirName:         "entities",
+			CollisionSuffix: "Entity",
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	bundlePath := filepath.Join(workingDirPath, "types.d.ts")
+	suite.FileExists(bundlePath)
+	suite.FileEquals(bundlePath, filepath.Join(gtBundleDirPath, "types.d.ts"))
+	suite.NoDirExists(filepath.Join(workingDirPath, "models"))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_Bundle_UnresolvableCollision() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	bundle := &compile.TsBundle{
+		TargetDirPath: workingDirPath,
+	}
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter:      &compile.BundleEnumFileWriter{Bundle: bundle, DirName: "enums"},
+		ModelWriter:     &compile.BundleObjectFileWriter{Bundle: bundle, DirName: "models"},
+		StructureWriter: &compile.BundleObjectFileWriter{Bundle: bundle, DirName: "structures"},
+		EntityWriter:    &compile.BundleObjectFileWriter{Bundle: bundle, DirName: "entities"},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.ErrorContains(compileErr, "bundled type name 'Company' of 'entities/company' collides with 'models/company'")
+}
+
+func (suite *CompileTestSuite) TestBundleObjectFileWriter_Flush() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	bundle := &compile.TsBundle{
+		TargetDirPath: workingDirPath,
+	}
+	objectWriter := compile.BundleObjectFileWriter{Bundle: bundle, DirName: "models"}
+
+	_, writeErr := objectWriter.WriteObject("Company", &tsdef.Object{
+		Name: "Company",
+		Fields: []tsdef.ObjectField{
+			{Name: "id", Type: tsdef.TsTypeNumber},
+		},
+	})
+	suite.NoError(writeErr)
+
+	personContents, writeErr := objectWriter.WriteObject("Person", &tsdef.Object{
+		Name: "Person",
+		Fields: []tsdef.ObjectField{
+			{Name: "company", Type: tsdef.TsTypeObject{ModulePath: "./company", Name: "Company"}},
+		},
+	})
+
+	suite.NoError(writeErr)
+	suite.Equal(`export type Person = {
+	company: Company
+}
+`, string(personContents))
+	suite.NoFileExists(filepath.Join(workingDirPath, "types.d.ts"))
+
+	flushErr := objectWriter.Flush()
+
+	suite.NoError(flushErr)
+	bundleContents, readErr := os.ReadFile(filepath.Join(workingDirPath, "types.d.ts"))
+	suite.NoError(readErr)
+	suite.Equal(`export type Company = {
+	id: number
+}
+
+export type Person = {
+	company: Company
+}
+`, string(bundleContents))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_TypeNaming() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtTypeNamingDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-type-naming")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.MorpheModelsConfig.TypeNaming = cfg.TypeNaming{
+		Suffix: "Model",
+	}
+	config.MorpheEntitiesConfig.TypeNaming = cfg.TypeNaming{
+		Suffix: "Entity",
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"index.ts",
+		"models/index.ts",
+		"models/comment-model.d.ts",
+		"models/company-model.d.ts",
+		"models/contact-model.d.ts",
+		"models/contact-info-model.d.ts",
+		"models/person-model.d.ts",
+		"entities/index.ts",
+		"entities/company-entity.d.ts",
+		"entities/person-entity.d.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtTypeNamingDirPath, filePath))
+	}
+	suite.NoFileExists(filepath.Join(workingDirPath, "models", "person.d.ts"))
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_TypeMappings() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtTypeMappingsDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-type-mappings")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	typeMappings := cfg.TypeMappings{
+		"AutoIncrement": {
+			TypeName:   "Id",
+			ModulePath: "@acme/ids",
+		},
+	}
+	config.MorpheModelsConfig.TypeMappings = typeMappings
+	config.MorpheStructuresConfig.TypeMappings = typeMappings
+	config.MorpheEntitiesConfig.TypeMappings = typeMappings
+	config.ModelWriter = &compile.MultiObjectWriter{
+		Writers: []write.TsObjectWriter{
+			config.ModelWriter,
+			&compile.ZodObjectFileWriter{
+				TargetDirPath: filepath.Join(workingDirPath, "zod", "models"),
+			},
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"models/comment.d.ts",
+		"models/company.d.ts",
+		"models/contact.d.ts",
+		"models/contact-info.d.ts",
+		"models/person.d.ts",
+		"entities/company.d.ts",
+		"entities/person.d.ts",
+		"zod/models/person.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtTypeMappingsDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_SourceFileDocs() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	registryDirPath := filepath.Join(workingDirPath, "registry")
+	for _, dirName := range []string{"enums", "models", "structures", "entities"} {
+		suite.Nil(os.MkdirAll(filepath.Join(registryDirPath, dirName), 0755))
+	}
+	suite.Nil(os.WriteFile(filepath.Join(registryDirPath, "models", "people.mod"), []byte(`name: Person
+fields:
+  ID:
+    type: AutoIncrement
+identifiers:
+  primary: ID
+`), 0644))
+
+	outputDirPath := filepath.Join(workingDirPath, "output")
+	config := compile.DefaultMorpheCompileConfig(registryDirPath, outputDirPath)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	personContents, readErr := os.ReadFile(filepath.Join(outputDirPath, "models", "person.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(personContents), "/** Morphe model `Person` from `people.mod` */\nexport type Person = {")
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_BrandedIDs() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtBrandedIDsDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-branded-ids")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.MorpheModelsConfig.BrandedIDs = true
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"models/comment.d.ts",
+		"models/company.d.ts",
+		"models/contact.d.ts",
+		"models/contact-info.d.ts",
+		"models/person.d.ts",
+		"entities/company.d.ts",
+		"entities/person.d.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtBrandedIDsDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_BrandedIDs_Zod() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtBrandedIDsDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-branded-ids", "zod")
+
+	config := compile.MorpheCompileConfig{
+		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
+			RegistryEnumsDirPath:      suite.EnumsDirPath,
+			RegistryStructuresDirPath: suite.StructuresDirPath,
+			RegistryModelsDirPath:     suite.ModelsDirPath,
+			RegistryEntitiesDirPath:   suite.EntitiesDirPath,
+		},
+
+		EnumWriter: &compile.ZodEnumFileWriter{
+			TargetDirPath: workingDirPath + "/enums",
+		},
+		ModelWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/models",
+		},
+		EntityWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/entities",
+		},
+		StructureWriter: &compile.ZodObjectFileWriter{
+			TargetDirPath: workingDirPath + "/structures",
+		},
+	}
+	config.MorpheModelsConfig.BrandedIDs = true
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"models/comment.ts",
+		"models/company.ts",
+		"models/contact.ts",
+		"models/contact-info.ts",
+		"models/person.ts",
+		"entities/company.ts",
+		"entities/person.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtBrandedIDsDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_InputTypes() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtInputTypesDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-input-types")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.MorpheModelsConfig.InputTypes = true
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"models/comment.d.ts",
+		"models/company.d.ts",
+		"models/contact.d.ts",
+		"models/contact-info.d.ts",
+		"models/person.d.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtInputTypesDirPath, filePath))
+	}
+}
+
+func (suite *CompileTestSuite) TestMorpheToTypescript_DiscriminatedUnions() {
+	workingDirPath := suite.TestDirPath + "/working"
+	suite.Nil(os.Mkdir(workingDirPath, 0644))
+	defer os.RemoveAll(workingDirPath)
+
+	gtDiscriminatedUnionsDirPath := filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-discriminated-unions")
+
+	config := compile.DefaultMorpheCompileConfig(filepath.Join(suite.TestDirPath, "registry", "minimal"), workingDirPath)
+	config.MorpheModelsConfig.DiscriminatedUnions = true
+	config.ModelWriter = &compile.MultiObjectWriter{
+		Writers: []write.TsObjectWriter{
+			config.ModelWriter,
+			&compile.TypeGuardObjectFileWriter{
+				TargetDirPath: filepath.Join(workingDirPath, "models"),
+			},
+		},
+	}
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	allFilePaths := []string{
+		"models/comment.d.ts",
+		"models/comment.guard.ts",
+	}
+	for _, filePath := range allFilePaths {
+		suite.FileExists(filepath.Join(workingDirPath, filePath))
+		suite.FileEquals(filepath.Join(workingDirPath, filePath), filepath.Join(gtDiscriminatedUnionsDirPath, filePath))
+	}
 }
@@ -0,0 +1,62 @@
+package compile
+
+import (
+	"strings"
+
+	"github.com/kalo-build/morphe-go/pkg/yaml"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/typemap"
+)
+
+// getTsTypeForMorpheModelFieldType resolves a primitive model field type, preferring the configured type mappings
+// over the default type map.
+func getTsTypeForMorpheModelFieldType(typeMappings cfg.TypeMappings, fieldType yaml.ModelFieldType) (tsdef.TsType, error) {
+	if typeMapping, hasMapping := typeMappings[string(fieldType)]; hasMapping {
+		return getTsTypeForTypeMapping(typeMapping), nil
+	}
+	tsType, typeSupported := typemap.MorpheModelFieldToTsField[fieldType]
+	if !typeSupported {
+		return nil, ErrUnsupportedMorpheFieldType(fieldType)
+	}
+	return tsType, nil
+}
+
+// getTsTypeForMorpheStructureFieldType resolves a primitive structure field type, preferring the configured type
+// mappings over the default type map.
+func getTsTypeForMorpheStructureFieldType(typeMappings cfg.TypeMappings, fieldType yaml.StructureFieldType) (tsdef.TsType, error) {
+	if typeMapping, hasMapping := typeMappings[string(fieldType)]; hasMapping {
+		return getTsTypeForTypeMapping(typeMapping), nil
+	}
+	tsType, typeSupported := typemap.MorpheStructureFieldToTsField[fieldType]
+	if !typeSupported {
+		return nil, ErrUnsupportedMorpheFieldType(fieldType)
+	}
+	return tsType, nil
+}
+
+func getTsTypeForTypeMapping(typeMapping cfg.TypeMapping) tsdef.TsType {
+	if typeMapping.ModulePath != "" {
+		return tsdef.TsTypeObject{
+			ModulePath: typeMapping.ModulePath,
+			Name:       typeMapping.TypeName,
+		}
+	}
+	if typeMapping.TypeName == tsdef.TsTypeDate.Name {
+		return tsdef.TsTypeDate
+	}
+	return tsdef.TsTypePrimitive{
+		Syntax: typeMapping.TypeName,
+	}
+}
+
+// isExternalTsTypeObject reports whether the object type is imported from a package instead of a generated file.
+//
+// Generated files always import each other through relative module paths.
+func isExternalTsTypeObject(objectType tsdef.TsTypeObject) bool {
+	return isExternalModulePath(objectType.ModulePath)
+}
+
+func isExternalModulePath(modulePath string) bool {
+	return modulePath != "" && !strings.HasPrefix(modulePath, ".")
+}
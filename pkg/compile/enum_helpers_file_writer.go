@@ -0,0 +1,122 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+	ti "time"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// EnumHelpersFileWriter writes runtime helpers of enums into `.helpers.ts` files: the ordered `NationalityValues` and
+// `NationalityKeys` arrays, the `NationalityByValue` reverse lookup of entry names and an `isNationality` guard.
+//
+// Helper files only import the enum type, so they work with every enum style. They are written next to the enum
+// definitions they import from, so the writer should target the same directory as the enum definition writer.
+type EnumHelpersFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *EnumHelpersFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	allHelperLines, allLinesErr := w.getAllHelperLines(enumName, enumDefinition)
+	if allLinesErr != nil {
+		return nil, allLinesErr
+	}
+
+	helpersFileContents, helpersContentsErr := core.LinesToString(allHelperLines)
+	if helpersContentsErr != nil {
+		return nil, helpersContentsErr
+	}
+
+	return tsfile.WriteTsHelpersFile(w.TargetDirPath, enumName, helpersFileContents)
+}
+
+func (w *EnumHelpersFileWriter) getAllHelperLines(enumName string, enumDefinition *tsdef.Enum) ([]string, error) {
+	keysName := getEnumKeysName(enumDefinition.Name)
+	keyTypeName := getEnumKeyTypeName(enumDefinition.Name)
+	valuesName := getEnumValuesName(enumDefinition.Name)
+
+	allKeyLiterals := []string{}
+	allValueLiterals := []string{}
+	allByValueLines := []string{}
+	for _, enumEntry := range enumDefinition.Entries {
+		keyLiteral := tsdef.GetTsStringLiteralSyntax(getTsEnumEntryName(enumEntry.Name), tsdef.TsLiteralQuoteSingle)
+		valueLiteral, valueErr := w.getEnumEntryValueLiteral(enumDefinition.Type, enumEntry.Value)
+		if valueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, valueErr)
+		}
+		allKeyLiterals = append(allKeyLiterals, keyLiteral)
+		allValueLiterals = append(allValueLiterals, valueLiteral)
+		allByValueLines = append(allByValueLines, fmt.Sprintf("\t%s: %s,", getObjectLiteralPropertyKey(valueLiteral), keyLiteral))
+	}
+
+	allHelperLines := []string{
+		fmt.Sprintf(`import type { %s } from "./%s"`, enumDefinition.Name, strcase.ToKebabCaseLower(enumName)),
+		"",
+		fmt.Sprintf("export const %s = Object.freeze([%s] as const)", keysName, strings.Join(allKeyLiterals, ", ")),
+		"",
+		fmt.Sprintf("export type %s = (typeof %s)[number]", keyTypeName, keysName),
+		"",
+		fmt.Sprintf("export const %s = Object.freeze([%s]) as readonly %s[]", valuesName, strings.Join(allValueLiterals, ", "), enumDefinition.Name),
+		"",
+		fmt.Sprintf("export const %s = Object.freeze({", getEnumByValueName(enumDefinition.Name)),
+	}
+	allHelperLines = append(allHelperLines, allByValueLines...)
+	allHelperLines = append(allHelperLines,
+		fmt.Sprintf("}) as Readonly<Record<%s, %s>>", enumDefinition.Name, keyTypeName),
+		"",
+		fmt.Sprintf("export function %s(value: unknown): value is %s {", getTypeGuardName(enumDefinition.Name), enumDefinition.Name),
+		fmt.Sprintf("\treturn (%s as readonly unknown[]).includes(value)", valuesName),
+		"}",
+	)
+	return allHelperLines, nil
+}
+
+// getEnumEntryValueLiteral formats the entry value as a literal of the enum type, so entries of string enums are always
+// quoted, even if their YAML value is not a string (e.g. `Code: 1`).
+func (w *EnumHelpersFileWriter) getEnumEntryValueLiteral(enumType tsdef.TsType, value any) (string, error) {
+	if enumType == nil || enumType.GetSyntax() != tsdef.TsTypeString.GetSyntax() {
+		return getEnumValueLiteral(value)
+	}
+	switch value.(type) {
+	case string, ti.Time:
+		return getEnumValueLiteral(value)
+	default:
+		valueSyntax, valueErr := tsdef.GetTsLiteralSyntax(value, tsdef.TsLiteralQuoteSingle)
+		if valueErr != nil {
+			return "", valueErr
+		}
+		return tsdef.GetTsStringLiteralSyntax(valueSyntax, tsdef.TsLiteralQuoteSingle), nil
+	}
+}
+
+// getObjectLiteralPropertyKey computes negative number keys (`[-1]: ...`), which are not valid property names.
+func getObjectLiteralPropertyKey(literal string) string {
+	if strings.HasPrefix(literal, "-") {
+		return "[" + literal + "]"
+	}
+	return literal
+}
+
+func (w *EnumHelpersFileWriter) ClearFile(enumName string) error {
+	return tsfile.ClearTsHelpersFile(w.TargetDirPath, enumName)
+}
+
+func getEnumKeysName(enumName string) string {
+	return enumName + "Keys"
+}
+
+func getEnumKeyTypeName(enumName string) string {
+	return enumName + "Key"
+}
+
+func getEnumValuesName(enumName string) string {
+	return enumName + "Values"
+}
+
+func getEnumByValueName(enumName string) string {
+	return enumName + "ByValue"
+}
@@ -0,0 +1,158 @@
+package compile
+
+import (
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// getJsonSchemaDocument wraps all definitions of a single file into a JSON Schema document which references the first definition.
+func getJsonSchemaDocument(allDefinitions map[string]any, rootDefinitionName string) map[string]any {
+	return map[string]any{
+		"$schema": jsonSchemaDialect,
+		"$ref":    getJsonSchemaLocalRef(rootDefinitionName),
+		"$defs":   allDefinitions,
+	}
+}
+
+func getJsonSchemaForObject(objectDefinition *tsdef.Object) map[string]any {
+	if objectDefinition.Alias != nil {
+		return getJsonSchemaForTsType(objectDefinition.Alias)
+	}
+
+	allProperties := map[string]any{}
+	allRequiredNames := []string{}
+	for _, objectField := range objectDefinition.Fields {
+		fieldName := getTsFieldName(objectField.Name)
+		fieldSchema := getJsonSchemaForTsType(objectField.Type)
+		if objectField.DateOnly {
+			setJsonSchemaDateOnlyFormat(fieldSchema)
+		}
+		if objectField.Readonly {
+			fieldSchema["readOnly"] = true
+		}
+		allProperties[fieldName] = fieldSchema
+		if !objectField.Type.IsOptional() {
+			allRequiredNames = append(allRequiredNames, fieldName)
+		}
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           allProperties,
+		"required":             allRequiredNames,
+		"additionalProperties": false,
+	}
+}
+
+func getJsonSchemaForEnum(enumDefinition *tsdef.Enum) map[string]any {
+	allValues := []any{}
+	for _, enumEntry := range enumDefinition.Entries {
+		allValues = append(allValues, enumEntry.Value)
+	}
+
+	enumSchema := getJsonSchemaForTsType(enumDefinition.Type)
+	enumSchema["enum"] = allValues
+	return enumSchema
+}
+
+// getJsonSchemaForTsType renders the JSON Schema which validates values of the given TsType.
+//
+// Object types are referenced by `$ref`, following their module path to the sibling `.schema.json` file.
+func getJsonSchemaForTsType(tsType tsdef.TsType) map[string]any {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return getJsonSchemaForTsType(typedType.ValueType)
+	case tsdef.TsTypeArray:
+		return map[string]any{
+			"type":  "array",
+			"items": getJsonSchemaForTsType(typedType.ValueType),
+		}
+	case tsdef.TsTypeUnion:
+		if len(typedType.Types) == 1 {
+			return getJsonSchemaForTsType(typedType.Types[0])
+		}
+		allUnionSchemas := []any{}
+		for _, unionType := range typedType.Types {
+			allUnionSchemas = append(allUnionSchemas, getJsonSchemaForTsType(unionType))
+		}
+		return map[string]any{
+			"oneOf": allUnionSchemas,
+		}
+	case tsdef.TsTypeObject:
+		if typedType == tsdef.TsTypeDate {
+			return map[string]any{
+				"type":   "string",
+				"format": "date-time",
+			}
+		}
+		if isExternalTsTypeObject(typedType) {
+			return map[string]any{}
+		}
+		return map[string]any{
+			"$ref": getJsonSchemaObjectRef(typedType),
+		}
+	case tsdef.TsTypeBranded:
+		return getJsonSchemaForTsType(typedType.ValueType)
+	case tsdef.TsTypeNarrowed:
+		return map[string]any{
+			"allOf": []any{
+				getJsonSchemaForTsType(typedType.ValueType),
+				map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						typedType.FieldName: getJsonSchemaForTsType(typedType.FieldType),
+					},
+					"required": []any{
+						typedType.FieldName,
+					},
+				},
+			},
+		}
+	case tsdef.TsTypeStringLiteral:
+		return map[string]any{
+			"const": typedType.Value,
+		}
+	case tsdef.TsTypePrimitive:
+		return getJsonSchemaForPrimitive(typedType)
+	default:
+		return map[string]any{}
+	}
+}
+
+// setJsonSchemaDateOnlyFormat narrows the `date-time` format of a date-only field schema, or of its list items, to
+// `date`, since both Morphe `Time` and `Date` fields are typed as `Date`.
+func setJsonSchemaDateOnlyFormat(fieldSchema map[string]any) {
+	if fieldSchema["format"] == "date-time" {
+		fieldSchema["format"] = "date"
+	}
+	if itemsSchema, isList := fieldSchema["items"].(map[string]any); isList {
+		setJsonSchemaDateOnlyFormat(itemsSchema)
+	}
+}
+
+func getJsonSchemaForPrimitive(primitiveType tsdef.TsTypePrimitive) map[string]any {
+	switch primitiveType.Syntax {
+	case "string", "number", "boolean", "null":
+		return map[string]any{
+			"type": primitiveType.Syntax,
+		}
+	case "never":
+		return map[string]any{
+			"not": map[string]any{},
+		}
+	default:
+		return map[string]any{}
+	}
+}
+
+func getJsonSchemaObjectRef(objectType tsdef.TsTypeObject) string {
+	if objectType.ModulePath == "" {
+		return getJsonSchemaLocalRef(objectType.Name)
+	}
+	return objectType.ModulePath + ".schema.json" + getJsonSchemaLocalRef(objectType.Name)
+}
+
+func getJsonSchemaLocalRef(definitionName string) string {
+	return "#/$defs/" + definitionName
+}
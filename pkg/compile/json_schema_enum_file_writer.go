@@ -0,0 +1,31 @@
+package compile
+
+import (
+	"encoding/json"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// JsonSchemaEnumFileWriter writes enums as JSON Schema (draft 2020-12) `.schema.json` files.
+type JsonSchemaEnumFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *JsonSchemaEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	allDefinitions := map[string]any{
+		enumDefinition.Name: getJsonSchemaForEnum(enumDefinition),
+	}
+
+	schemaDocument := getJsonSchemaDocument(allDefinitions, enumDefinition.Name)
+	schemaContents, marshalErr := json.MarshalIndent(schemaDocument, "", "\t")
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+
+	return tsfile.WriteJsonSchemaFile(w.TargetDirPath, enumName, string(schemaContents)+"\n")
+}
+
+func (w *JsonSchemaEnumFileWriter) ClearFile(enumName string) error {
+	return tsfile.ClearJsonSchemaFile(w.TargetDirPath, enumName)
+}
@@ -0,0 +1,43 @@
+package compile
+
+import (
+	"encoding/json"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// JsonSchemaObjectFileWriter writes objects as JSON Schema (draft 2020-12) `.schema.json` files.
+//
+// All objects sharing a main object are collected under `$defs` of the same document, which is rewritten on every write.
+type JsonSchemaObjectFileWriter struct {
+	TargetDirPath string
+
+	allFileObjects map[string][]*tsdef.Object
+}
+
+func (w *JsonSchemaObjectFileWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	if w.allFileObjects == nil {
+		w.allFileObjects = map[string][]*tsdef.Object{}
+	}
+	w.allFileObjects[mainObjectName] = append(w.allFileObjects[mainObjectName], objectDefinition)
+
+	allFileObjects := w.allFileObjects[mainObjectName]
+	allDefinitions := map[string]any{}
+	for _, fileObject := range allFileObjects {
+		allDefinitions[fileObject.Name] = getJsonSchemaForObject(fileObject)
+	}
+
+	schemaDocument := getJsonSchemaDocument(allDefinitions, allFileObjects[0].Name)
+	schemaContents, marshalErr := json.MarshalIndent(schemaDocument, "", "\t")
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+
+	return tsfile.WriteJsonSchemaFile(w.TargetDirPath, mainObjectName, string(schemaContents)+"\n")
+}
+
+func (w *JsonSchemaObjectFileWriter) ClearFile(mainObjectName string) error {
+	delete(w.allFileObjects, mainObjectName)
+	return tsfile.ClearJsonSchemaFile(w.TargetDirPath, mainObjectName)
+}
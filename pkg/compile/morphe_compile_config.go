@@ -17,6 +17,11 @@ type MorpheCompileConfig struct {
 	cfg.MorpheStructuresConfig
 	cfg.MorpheEntitiesConfig
 
+	// OutputLayout names the output directory of each Morphe kind, used to link them in the imports and index files.
+	//
+	// The writers must target the same directories, which `MorpheToTypescript` validates for the writers of this package.
+	OutputLayout cfg.OutputLayout
+
 	RegistryHooks r.LoadMorpheRegistryHooks
 
 	EnumWriter write.TsEnumWriter
@@ -33,12 +38,17 @@ type MorpheCompileConfig struct {
 
 	StructureWriter write.TsObjectWriter
 	StructureHooks  hook.CompileMorpheStructure
+
+	// IndexWriter writes barrel index files for all output directories, skipped when nil or when the writers do not
+	// write `.d.ts` definition files for the index to re-export (e.g. Zod schemas or a bundle)
+	IndexWriter write.TsIndexWriter
 }
 
 func DefaultMorpheCompileConfig(
 	yamlRegistryPath string,
 	baseOutputDirPath string,
 ) MorpheCompileConfig {
+	outputLayout := cfg.OutputLayout{}
 	return MorpheCompileConfig{
 		MorpheLoadRegistryConfig: rcfg.MorpheLoadRegistryConfig{
 			RegistryEnumsDirPath:      path.Join(yamlRegistryPath, "enums"),
@@ -51,20 +61,22 @@ func DefaultMorpheCompileConfig(
 		MorpheStructuresConfig: cfg.MorpheStructuresConfig{},
 		MorpheEntitiesConfig:   cfg.MorpheEntitiesConfig{},
 
+		OutputLayout: outputLayout,
+
 		RegistryHooks: r.LoadMorpheRegistryHooks{},
 
 		EnumWriter: &MorpheEnumFileWriter{
-			TargetDirPath: path.Join(baseOutputDirPath, "enums"),
+			TargetDirPath: path.Join(baseOutputDirPath, outputLayout.GetEnumsDirName()),
 		},
 		EnumHooks: hook.CompileMorpheEnum{},
 
 		ModelWriter: &MorpheObjectFileWriter{
-			TargetDirPath: path.Join(baseOutputDirPath, "models"),
+			TargetDirPath: path.Join(baseOutputDirPath, outputLayout.GetModelsDirName()),
 		},
 		ModelHooks: hook.CompileMorpheModel{},
 
 		EntityWriter: &MorpheObjectFileWriter{
-			TargetDirPath: path.Join(baseOutputDirPath, "entities"),
+			TargetDirPath: path.Join(baseOutputDirPath, outputLayout.GetEntitiesDirName()),
 		},
 		EntityHooks: hook.CompileMorpheEntity{},
 
@@ -72,8 +84,12 @@ func DefaultMorpheCompileConfig(
 		WriteEnumHooks:   hook.WriteTsEnum{},
 
 		StructureWriter: &MorpheObjectFileWriter{
-			TargetDirPath: path.Join(baseOutputDirPath, "structures"),
+			TargetDirPath: path.Join(baseOutputDirPath, outputLayout.GetStructuresDirName()),
 		},
 		StructureHooks: hook.CompileMorpheStructure{},
+
+		IndexWriter: &MorpheIndexFileWriter{
+			TargetDirPath: baseOutputDirPath,
+		},
 	}
 }
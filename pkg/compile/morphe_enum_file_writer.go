@@ -2,16 +2,19 @@ package compile
 
 import (
 	"fmt"
+	"strings"
 	ti "time"
 
 	"github.com/kalo-build/go-util/core"
-	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
 )
 
 type MorpheEnumFileWriter struct {
 	TargetDirPath string
+	// Style is the form enums are declared in, defaults to `export enum`
+	Style cfg.EnumStyle
 }
 
 func (w *MorpheEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
@@ -34,37 +37,119 @@ func (w *MorpheEnumFileWriter) getAllEnumLines(enumName string, enumDefinition *
 		allEnumLines = append(allEnumLines, "")
 	}
 
-	allEnumLines = append(allEnumLines, fmt.Sprintf(`export enum %s {`, enumDefinition.Name))
+	allEnumLines = append(allEnumLines, getJsDocLines("", enumDefinition.Docs)...)
+	var styleLines []string
+	var styleLinesErr error
+	switch w.Style {
+	case cfg.EnumStyleEnum:
+		styleLines, styleLinesErr = w.getAllEnumDeclarationLines("export enum", enumDefinition)
+	case cfg.EnumStyleDeclareEnum:
+		styleLines, styleLinesErr = w.getAllEnumDeclarationLines("export declare enum", enumDefinition)
+	case cfg.EnumStyleConstEnum:
+		styleLines, styleLinesErr = w.getAllEnumDeclarationLines("export const enum", enumDefinition)
+	case cfg.EnumStyleUnion:
+		styleLines, styleLinesErr = w.getAllEnumUnionLines(enumDefinition)
+	case cfg.EnumStyleConstObject:
+		styleLines, styleLinesErr = w.getAllEnumConstObjectDeclarationLines(enumDefinition)
+	default:
+		return nil, cfg.ErrUnsupportedEnumStyle(w.Style)
+	}
+	if styleLinesErr != nil {
+		return nil, styleLinesErr
+	}
+	return append(allEnumLines, styleLines...), nil
+}
 
+func (w *MorpheEnumFileWriter) getAllEnumDeclarationLines(enumKeywords string, enumDefinition *tsdef.Enum) ([]string, error) {
+	allEnumLines := []string{
+		fmt.Sprintf(`%s %s {`, enumKeywords, enumDefinition.Name),
+	}
 	for enumIdx, enumEntry := range enumDefinition.Entries {
-		entryName := strcase.ToPascalCase(enumEntry.Name)
-		entryValue := w.formatEnumValue(enumEntry.Value)
-		enumEntryLine := fmt.Sprintf("\t%s = %v", entryName, entryValue)
+		entryValue, entryValueErr := getEnumValueLiteral(enumEntry.Value)
+		if entryValueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, entryValueErr)
+		}
+		allEnumLines = append(allEnumLines, getJsDocLines("\t", enumEntry.Docs)...)
+		entryName := tsdef.GetTsPropertyNameSyntax(getTsEnumEntryName(enumEntry.Name), tsdef.TsLiteralQuoteSingle)
+		enumEntryLine := fmt.Sprintf("\t%s = %s", entryName, entryValue)
 		if enumIdx != len(enumDefinition.Entries)-1 {
 			enumEntryLine += ","
 		}
 		allEnumLines = append(allEnumLines, enumEntryLine)
 	}
+	return append(allEnumLines, "}"), nil
+}
+
+// getAllEnumUnionLines declares the enum as the union of its entry values, entry names and docs are not part of the type.
+func (w *MorpheEnumFileWriter) getAllEnumUnionLines(enumDefinition *tsdef.Enum) ([]string, error) {
+	if len(enumDefinition.Entries) == 0 {
+		return []string{
+			fmt.Sprintf(`export type %s = never`, enumDefinition.Name),
+		}, nil
+	}
+	allEntryValues := []string{}
+	for _, enumEntry := range enumDefinition.Entries {
+		entryValue, entryValueErr := getEnumValueLiteral(enumEntry.Value)
+		if entryValueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, entryValueErr)
+		}
+		allEntryValues = append(allEntryValues, entryValue)
+	}
+	return []string{
+		fmt.Sprintf(`export type %s = %s`, enumDefinition.Name, strings.Join(allEntryValues, " | ")),
+	}, nil
+}
 
+// getAllEnumConstObjectDeclarationLines declares the frozen `as const` object of the enum along with the type of its values.
+//
+// Type definition files cannot hold the object itself, so it is declared by its readonly shape instead.
+func (w *MorpheEnumFileWriter) getAllEnumConstObjectDeclarationLines(enumDefinition *tsdef.Enum) ([]string, error) {
+	allEnumLines := []string{
+		fmt.Sprintf(`export declare const %s: {`, enumDefinition.Name),
+	}
+	for _, enumEntry := range enumDefinition.Entries {
+		entryValue, entryValueErr := getEnumValueLiteral(enumEntry.Value)
+		if entryValueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, entryValueErr)
+		}
+		allEnumLines = append(allEnumLines, getJsDocLines("\t", enumEntry.Docs)...)
+		allEnumLines = append(allEnumLines, fmt.Sprintf("\treadonly %s: %s", tsdef.GetTsPropertyNameSyntax(getTsEnumEntryName(enumEntry.Name), tsdef.TsLiteralQuoteSingle), entryValue))
+	}
 	allEnumLines = append(allEnumLines, "}")
-	return allEnumLines, nil
+	return append(allEnumLines, getEnumConstObjectTypeLine(enumDefinition.Name)), nil
 }
 
-func (w *MorpheEnumFileWriter) formatEnumValue(value any) string {
-	switch typedValue := value.(type) {
-	case string:
-		return fmt.Sprintf("'%v'", typedValue)
-	case ti.Time:
-		formattedValue := ""
-		if typedValue.Hour() == 0 && typedValue.Minute() == 0 && typedValue.Second() == 0 && typedValue.Nanosecond() == 0 {
-			formattedValue = typedValue.Format("2006-01-02")
-		} else {
-			formattedValue = typedValue.Format(ti.RFC3339)
+// getAllEnumConstObjectLines defines the frozen `as const` object of the enum along with the type of its values.
+func (w *MorpheEnumFileWriter) getAllEnumConstObjectLines(enumDefinition *tsdef.Enum) ([]string, error) {
+	allEnumLines := []string{
+		fmt.Sprintf(`export const %s = Object.freeze({`, enumDefinition.Name),
+	}
+	for _, enumEntry := range enumDefinition.Entries {
+		entryValue, entryValueErr := getEnumValueLiteral(enumEntry.Value)
+		if entryValueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, entryValueErr)
 		}
-		return fmt.Sprintf("'%v'", formattedValue)
-	default:
-		return fmt.Sprintf("%v", typedValue)
+		allEnumLines = append(allEnumLines, getJsDocLines("\t", enumEntry.Docs)...)
+		allEnumLines = append(allEnumLines, fmt.Sprintf("\t%s: %s,", tsdef.GetTsPropertyNameSyntax(getTsEnumEntryName(enumEntry.Name), tsdef.TsLiteralQuoteSingle), entryValue))
+	}
+	allEnumLines = append(allEnumLines, "} as const)")
+	return append(allEnumLines, getEnumConstObjectTypeLine(enumDefinition.Name)), nil
+}
+
+func getEnumConstObjectTypeLine(enumName string) string {
+	return fmt.Sprintf(`export type %s = (typeof %s)[keyof typeof %s]`, enumName, enumName, enumName)
+}
+
+// getEnumValueLiteral formats the entry value as a single quoted TypeScript literal, dates are formatted as ISO strings.
+func getEnumValueLiteral(value any) (string, error) {
+	timeValue, isTime := value.(ti.Time)
+	if !isTime {
+		return tsdef.GetTsLiteralSyntax(value, tsdef.TsLiteralQuoteSingle)
+	}
+	if timeValue.Hour() == 0 && timeValue.Minute() == 0 && timeValue.Second() == 0 && timeValue.Nanosecond() == 0 {
+		return tsdef.GetTsStringLiteralSyntax(timeValue.Format("2006-01-02"), tsdef.TsLiteralQuoteSingle), nil
 	}
+	return tsdef.GetTsStringLiteralSyntax(timeValue.Format(ti.RFC3339), tsdef.TsLiteralQuoteSingle), nil
 }
 
 func (w *MorpheEnumFileWriter) ClearFile(enumName string) error {
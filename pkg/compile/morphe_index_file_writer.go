@@ -0,0 +1,36 @@
+package compile
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+type MorpheIndexFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *MorpheIndexFileWriter) WriteIndex(indexDirName string, indexDefinition *tsdef.Index) ([]byte, error) {
+	if indexDefinition == nil {
+		return nil, ErrNoIndex
+	}
+
+	allIndexLines := []string{}
+	for _, indexExport := range indexDefinition.Exports {
+		if indexExport.Namespace != "" {
+			allIndexLines = append(allIndexLines, fmt.Sprintf(`export * as %s from "%s"`, indexExport.Namespace, indexExport.ModulePath))
+			continue
+		}
+		allIndexLines = append(allIndexLines, fmt.Sprintf(`export * from "%s"`, indexExport.ModulePath))
+	}
+
+	indexFileContents, indexContentsErr := core.LinesToString(allIndexLines)
+	if indexContentsErr != nil {
+		return nil, indexContentsErr
+	}
+
+	return tsfile.WriteTsIndexFile(filepath.Join(w.TargetDirPath, indexDirName), indexFileContents)
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/kalo-build/go-util/core"
-	"github.com/kalo-build/go-util/strcase"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
 	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
 )
@@ -45,10 +44,24 @@ func (w *MorpheObjectFileWriter) getAllObjectLines(mainObjectName string, object
 		allObjectLines = append(allObjectLines, "")
 	}
 
+	return append(allObjectLines, getAllObjectTypeLines(objectDefinition)...), nil
+}
+
+// getAllObjectTypeLines declares the object type along with its docs, e.g. `export type Person = { ... }`.
+func getAllObjectTypeLines(objectDefinition *tsdef.Object) []string {
+	allObjectLines := getJsDocLines("", objectDefinition.Docs)
+	if objectDefinition.Alias != nil {
+		return append(allObjectLines, fmt.Sprintf(`export type %s = %s`, objectDefinition.Name, objectDefinition.Alias.GetSyntax()))
+	}
+
 	allObjectLines = append(allObjectLines, fmt.Sprintf(`export type %s = {`, objectDefinition.Name))
 
 	for _, objectField := range objectDefinition.Fields {
-		fieldName := strcase.ToCamelCase(objectField.Name)
+		allObjectLines = append(allObjectLines, getJsDocLines("\t", objectField.Docs)...)
+		fieldName := tsdef.GetTsPropertyNameSyntax(getTsFieldName(objectField.Name), tsdef.TsLiteralQuoteDouble)
+		if objectField.Readonly {
+			fieldName = "readonly " + fieldName
+		}
 		fieldTypeSyntax := objectField.Type.GetSyntax()
 		if objectField.Type.IsOptional() {
 			structFieldLine := fmt.Sprintf("\t%s?: %s", fieldName, fieldTypeSyntax)
@@ -59,8 +72,7 @@ func (w *MorpheObjectFileWriter) getAllObjectLines(mainObjectName string, object
 		allObjectLines = append(allObjectLines, structFieldLine)
 	}
 
-	allObjectLines = append(allObjectLines, "}")
-	return allObjectLines, nil
+	return append(allObjectLines, "}")
 }
 
 func (w *MorpheObjectFileWriter) getAllObjectImportLines(objectDefinition *tsdef.Object) ([]string, error) {
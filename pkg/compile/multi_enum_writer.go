@@ -0,0 +1,50 @@
+package compile
+
+import (
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// MultiEnumWriter writes each enum with all of its writers, e.g. to emit type guards alongside enum definitions.
+//
+// The returned contents are those of the first writer.
+type MultiEnumWriter struct {
+	Writers []write.TsEnumWriter
+}
+
+func (w *MultiEnumWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	var mainContents []byte
+	for writerIdx, writer := range w.Writers {
+		enumContents, writeErr := writer.WriteEnum(enumName, enumDefinition)
+		if writeErr != nil {
+			return nil, writeErr
+		}
+		if writerIdx == 0 {
+			mainContents = enumContents
+		}
+	}
+	return mainContents, nil
+}
+
+func (w *MultiEnumWriter) ClearFile(enumName string) error {
+	for _, writer := range w.Writers {
+		if clearErr := writer.ClearFile(enumName); clearErr != nil {
+			return clearErr
+		}
+	}
+	return nil
+}
+
+// Flush flushes all writers which accumulate their enums.
+func (w *MultiEnumWriter) Flush() error {
+	for _, writer := range w.Writers {
+		flusher, isFlusher := writer.(write.TsFlusher)
+		if !isFlusher {
+			continue
+		}
+		if flushErr := flusher.Flush(); flushErr != nil {
+			return flushErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package compile
+
+import (
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// MultiObjectWriter writes each object with all of its writers, e.g. to emit type guards alongside type definitions.
+//
+// The returned contents are those of the first writer.
+type MultiObjectWriter struct {
+	Writers []write.TsObjectWriter
+}
+
+func (w *MultiObjectWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	var mainContents []byte
+	for writerIdx, writer := range w.Writers {
+		objectContents, writeErr := writer.WriteObject(mainObjectName, objectDefinition)
+		if writeErr != nil {
+			return nil, writeErr
+		}
+		if writerIdx == 0 {
+			mainContents = objectContents
+		}
+	}
+	return mainContents, nil
+}
+
+func (w *MultiObjectWriter) ClearFile(mainObjectName string) error {
+	for _, writer := range w.Writers {
+		if clearErr := writer.ClearFile(mainObjectName); clearErr != nil {
+			return clearErr
+		}
+	}
+	return nil
+}
+
+// Flush flushes all writers which accumulate their objects.
+func (w *MultiObjectWriter) Flush() error {
+	for _, writer := range w.Writers {
+		flusher, isFlusher := writer.(write.TsFlusher)
+		if !isFlusher {
+			continue
+		}
+		if flushErr := flusher.Flush(); flushErr != nil {
+			return flushErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package compile
+
+import (
+	"path"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+)
+
+// PluginMorpheCompileConfig creates the compile config for the plugin options passed through the CLI on top of
+// `DefaultMorpheCompileConfig`.
+func PluginMorpheCompileConfig(
+	yamlRegistryPath string,
+	baseOutputDirPath string,
+	options cfg.PluginOptions,
+) (MorpheCompileConfig, error) {
+	if validateErr := options.Validate(); validateErr != nil {
+		return MorpheCompileConfig{}, validateErr
+	}
+
+	config := DefaultMorpheCompileConfig(yamlRegistryPath, baseOutputDirPath)
+	config.OutputLayout = options.Layout
+	config.MorpheEnumsConfig = options.Enums
+	config.MorpheModelsConfig = options.Models
+	config.MorpheEntitiesConfig = options.Entities
+	config.MorpheModelsConfig.TypeMappings = options.TypeMappings
+	config.MorpheStructuresConfig.TypeMappings = options.TypeMappings
+	config.MorpheEntitiesConfig.TypeMappings = options.TypeMappings
+	config.MorpheEnumsConfig.IdentifierHandling = options.IdentifierHandling
+	config.MorpheModelsConfig.IdentifierHandling = options.IdentifierHandling
+	config.MorpheStructuresConfig.IdentifierHandling = options.IdentifierHandling
+	config.MorpheEntitiesConfig.IdentifierHandling = options.IdentifierHandling
+
+	var bundle *TsBundle
+	if options.Bundle != "" && options.HasOutput(cfg.OutputKindTypes) {
+		bundle = &TsBundle{
+			TargetDirPath: baseOutputDirPath,
+			BundleName:    options.Bundle,
+			EnumStyle:     options.Enums.Style,
+		}
+	}
+
+	layout := options.Layout
+	config.EnumWriter = getPluginEnumWriter(options, bundle, baseOutputDirPath, layout.GetEnumsDirName())
+	config.ModelWriter = getPluginObjectWriter(options, bundle, baseOutputDirPath, layout.GetModelsDirName(), "Model")
+	config.StructureWriter = getPluginObjectWriter(options, bundle, baseOutputDirPath, layout.GetStructuresDirName(), "Structure")
+	config.EntityWriter = getPluginObjectWriter(options, bundle, baseOutputDirPath, layout.GetEntitiesDirName(), "Entity")
+
+	config.IndexWriter = nil
+	if options.Index && bundle == nil && options.HasOutput(cfg.OutputKindTypes) {
+		config.IndexWriter = &MorpheIndexFileWriter{
+			TargetDirPath: baseOutputDirPath,
+		}
+	}
+	return config, nil
+}
+
+func getPluginEnumWriter(options cfg.PluginOptions, bundle *TsBundle, baseOutputDirPath string, dirName string) write.TsEnumWriter {
+	targetDirPath := path.Join(baseOutputDirPath, dirName)
+
+	allWriters := []write.TsEnumWriter{}
+	for _, outputKind := range options.Outputs {
+		switch outputKind {
+		case cfg.OutputKindTypes:
+			if bundle != nil {
+				allWriters = append(allWriters, &BundleEnumFileWriter{Bundle: bundle, DirName: dirName, CollisionSuffix: "Enum"})
+				continue
+			}
+			allWriters = append(allWriters, &MorpheEnumFileWriter{TargetDirPath: targetDirPath, Style: options.Enums.Style})
+		case cfg.OutputKindZod:
+			allWriters = append(allWriters, &ZodEnumFileWriter{TargetDirPath: path.Join(baseOutputDirPath, cfg.ZodOutputDirName, dirName), Style: options.Enums.Style})
+		case cfg.OutputKindJsonSchema:
+			allWriters = append(allWriters, &JsonSchemaEnumFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindGuards:
+			allWriters = append(allWriters, &TypeGuardEnumFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindWireFormat:
+			allWriters = append(allWriters, &WireFormatEnumFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindEnumHelpers:
+			allWriters = append(allWriters, &EnumHelpersFileWriter{TargetDirPath: targetDirPath})
+		}
+	}
+
+	if len(allWriters) == 1 {
+		return allWriters[0]
+	}
+	return &MultiEnumWriter{Writers: allWriters}
+}
+
+func getPluginObjectWriter(options cfg.PluginOptions, bundle *TsBundle, baseOutputDirPath string, dirName string, collisionSuffix string) write.TsObjectWriter {
+	targetDirPath := path.Join(baseOutputDirPath, dirName)
+
+	allWriters := []write.TsObjectWriter{}
+	for _, outputKind := range options.Outputs {
+		switch outputKind {
+		case cfg.OutputKindTypes:
+			if bundle != nil {
+				allWriters = append(allWriters, &BundleObjectFileWriter{Bundle: bundle, DirName: dirName, CollisionSuffix: collisionSuffix})
+				continue
+			}
+			allWriters = append(allWriters, &MorpheObjectFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindZod:
+			allWriters = append(allWriters, &ZodObjectFileWriter{TargetDirPath: path.Join(baseOutputDirPath, cfg.ZodOutputDirName, dirName)})
+		case cfg.OutputKindJsonSchema:
+			allWriters = append(allWriters, &JsonSchemaObjectFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindGuards:
+			allWriters = append(allWriters, &TypeGuardObjectFileWriter{TargetDirPath: targetDirPath})
+		case cfg.OutputKindWireFormat:
+			allWriters = append(allWriters, &WireFormatObjectFileWriter{TargetDirPath: targetDirPath})
+		}
+	}
+
+	if len(allWriters) == 1 {
+		return allWriters[0]
+	}
+	return &MultiObjectWriter{Writers: allWriters}
+}
@@ -0,0 +1,467 @@
+package compile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kalo-build/go-util/assertfile"
+	"github.com/kalo-build/plugin-morphe-ts-types/internal/testutils"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+)
+
+type PluginCompileConfigTestSuite struct {
+	assertfile.FileSuite
+
+	TestDirPath     string
+	RegistryDirPath string
+	WorkingDirPath  string
+}
+
+func TestPluginCompileConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(PluginCompileConfigTestSuite))
+}
+
+func (suite *PluginCompileConfigTestSuite) SetupTest() {
+	suite.TestDirPath = testutils.GetTestDirPath()
+	suite.RegistryDirPath = filepath.Join(suite.TestDirPath, "registry", "minimal")
+	suite.WorkingDirPath = filepath.Join(suite.TestDirPath, "working")
+}
+
+func (suite *PluginCompileConfigTestSuite) TearDownTest() {
+	suite.TestDirPath = ""
+}
+
+func (suite *PluginCompileConfigTestSuite) TestDecodePluginOptions_Defaults() {
+	options, optionsErr := cfg.DecodePluginOptions(nil)
+
+	suite.NoError(optionsErr)
+	suite.Equal(cfg.DefaultPluginOptions(), options)
+	suite.True(options.Index)
+	suite.Equal([]cfg.OutputKind{cfg.OutputKindTypes}, options.Outputs)
+	suite.NoError(options.Validate())
+}
+
+func (suite *PluginCompileConfigTestSuite) TestDecodePluginOptions() {
+	rawOptions := map[string]any{
+		"layout": map[string]any{
+			"enums":  "e",
+			"models": "m",
+		},
+		"bundle":  "all-types",
+		"index":   false,
+		"outputs": []any{"types", "jsonSchema"},
+		"typeMappings": map[string]any{
+			"Time": "string",
+			"UUID": map[string]any{
+				"type":   "Uuid",
+				"module": "@acme/types",
+			},
+		},
+		"identifierHandling": "quote",
+		"enums": map[string]any{
+			"style":      "union",
+			"entryOrder": "declared",
+		},
+		"models": map[string]any{
+			"optionality": "optional",
+			"typeSuffix":  "Model",
+			"brandedIDs":  true,
+			"inputTypes":  true,
+		},
+		"entities": map[string]any{
+			"optionality":         "nullable",
+			"typePrefix":          "Api",
+			"discriminatedUnions": true,
+		},
+	}
+
+	options, optionsErr := cfg.DecodePluginOptions(rawOptions)
+
+	suite.NoError(optionsErr)
+	suite.Equal(cfg.PluginOptions{
+		Layout: cfg.OutputLayout{
+			EnumsDirName:  "e",
+			ModelsDirName: "m",
+		},
+		Bundle:  "all-types",
+		Index:   false,
+		Outputs: []cfg.OutputKind{cfg.OutputKindTypes, cfg.OutputKindJsonSchema},
+		TypeMappings: cfg.TypeMappings{
+			"Time": {
+				TypeName: "string",
+			},
+			"UUID": {
+				TypeName:   "Uuid",
+				ModulePath: "@acme/types",
+			},
+		},
+		IdentifierHandling: cfg.IdentifierHandlingQuote,
+		Enums: cfg.MorpheEnumsConfig{
+			Style:      cfg.EnumStyleUnion,
+			EntryOrder: cfg.EnumEntryOrderDeclared,
+		},
+		Models: cfg.MorpheModelsConfig{
+			FieldOptionality: cfg.FieldOptionalityOptional,
+			TypeNaming: cfg.TypeNaming{
+				Suffix: "Model",
+			},
+			BrandedIDs: true,
+			InputTypes: true,
+		},
+		Entities: cfg.MorpheEntitiesConfig{
+			FieldOptionality: cfg.FieldOptionalityNullable,
+			TypeNaming: cfg.TypeNaming{
+				Prefix: "Api",
+			},
+			DiscriminatedUnions: true,
+		},
+	}, options)
+	suite.NoError(options.Validate())
+}
+
+func (suite *PluginCompileConfigTestSuite) TestDecodePluginOptions_UnknownOption() {
+	_, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"outputDir": "types",
+	})
+
+	suite.ErrorContains(optionsErr, "unknown plugin option 'outputDir'")
+}
+
+func (suite *PluginCompileConfigTestSuite) TestDecodePluginOptions_UnknownNestedOption() {
+	_, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"models": map[string]any{
+			"typeSufix": "Model",
+		},
+	})
+
+	suite.ErrorContains(optionsErr, "unknown plugin option 'models.typeSufix'")
+
+	_, optionsErr = cfg.DecodePluginOptions(map[string]any{
+		"entities": map[string]any{
+			"brandedIDs": true,
+		},
+	})
+
+	suite.ErrorContains(optionsErr, "unknown plugin option 'entities.brandedIDs'")
+}
+
+func (suite *PluginCompileConfigTestSuite) TestDecodePluginOptions_InvalidOptionType() {
+	_, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"layout": map[string]any{
+			"enums": 1.0,
+		},
+	})
+
+	suite.ErrorContains(optionsErr, "invalid plugin option 'layout.enums', must be a string")
+
+	_, optionsErr = cfg.DecodePluginOptions(map[string]any{
+		"outputs": "types",
+	})
+
+	suite.ErrorContains(optionsErr, "invalid plugin option 'outputs', must be a list of strings")
+
+	_, optionsErr = cfg.DecodePluginOptions(map[string]any{
+		"typeMappings": map[string]any{
+			"Time": true,
+		},
+	})
+
+	suite.ErrorContains(optionsErr, "invalid plugin option 'typeMappings.Time', must be a string or an object")
+
+	_, optionsErr = cfg.DecodePluginOptions(map[string]any{
+		"typeMappings": map[string]any{
+			"UUID": map[string]any{"name": "Uuid"},
+		},
+	})
+
+	suite.ErrorContains(optionsErr, "unknown plugin option 'typeMappings.UUID.name'")
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginOptionsValidate_InvalidValues() {
+	allInvalidOptions := map[string]map[string]any{
+		"invalid plugin option 'identifierHandling': unsupported identifier handling 'escape'": {
+			"identifierHandling": "escape",
+		},
+		"invalid plugin option 'enums.style': unsupported enum style 'flags'": {
+			"enums": map[string]any{"style": "flags"},
+		},
+		"invalid plugin option 'enums.entryOrder': unsupported enum entry order 'reversed'": {
+			"enums": map[string]any{"entryOrder": "reversed"},
+		},
+		"invalid plugin option 'models.optionality': unsupported field optionality 'maybe'": {
+			"models": map[string]any{"optionality": "maybe"},
+		},
+		"invalid plugin option 'entities': invalid type name suffix 'Entity!', must only contain letters, digits, '_' or '$'": {
+			"entities": map[string]any{"typeSuffix": "Entity!"},
+		},
+		"invalid plugin option 'outputs': unsupported output 'openapi'": {
+			"outputs": []any{"types", "openapi"},
+		},
+		"invalid plugin option 'outputs': duplicate output 'types'": {
+			"outputs": []any{"types", "types"},
+		},
+		"invalid plugin option 'outputs': at least one output must be enabled": {
+			"outputs": []any{},
+		},
+		"invalid plugin option 'outputs': guards output requires the types output without bundle": {
+			"bundle":  "types",
+			"outputs": []any{"types", "guards"},
+		},
+		"invalid plugin option 'outputs': wireFormat output requires the types output without bundle": {
+			"outputs": []any{"wireFormat"},
+		},
+		"invalid plugin option 'outputs': enumHelpers output requires the types output without bundle": {
+			"bundle":  "types",
+			"outputs": []any{"types", "enumHelpers"},
+		},
+		"invalid plugin option 'outputs': output directory 'zod' is reserved for the zod output": {
+			"layout":  map[string]any{"models": "zod"},
+			"outputs": []any{"types", "zod"},
+		},
+		"invalid plugin option 'layout': invalid models output directory '../models', must be a single directory name": {
+			"layout": map[string]any{"models": "../models"},
+		},
+		"invalid plugin option 'layout': entities output directory 'types' is already used by models": {
+			"layout": map[string]any{"models": "types", "entities": "types"},
+		},
+		"invalid plugin option 'typeMappings': type mapping 'Uuid' for 'UUID' is not a builtin type and requires a module path": {
+			"typeMappings": map[string]any{"UUID": "Uuid"},
+		},
+		"invalid plugin option 'bundle': invalid bundle name '../types', must be a plain file name": {
+			"bundle": "../types",
+		},
+	}
+
+	for expectedErr, rawOptions := range allInvalidOptions {
+		options, optionsErr := cfg.DecodePluginOptions(rawOptions)
+		suite.NoError(optionsErr)
+
+		_, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+		suite.EqualError(configErr, expectedErr)
+	}
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_Layout() {
+	suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+	defer os.RemoveAll(suite.WorkingDirPath)
+
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"layout": map[string]any{
+			"enums":      "e",
+			"models":     "m",
+			"structures": "s",
+		},
+		"outputs": []any{"types", "jsonSchema"},
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+	suite.NoError(configErr)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	suite.FileExists(filepath.Join(suite.WorkingDirPath, "e", "nationality.d.ts"))
+	suite.FileExists(filepath.Join(suite.WorkingDirPath, "e", "nationality.schema.json"))
+	suite.FileExists(filepath.Join(suite.WorkingDirPath, "m", "person.d.ts"))
+	suite.FileExists(filepath.Join(suite.WorkingDirPath, "m", "person.schema.json"))
+	suite.FileExists(filepath.Join(suite.WorkingDirPath, "entities", "person.d.ts"))
+	suite.NoDirExists(filepath.Join(suite.WorkingDirPath, "models"))
+
+	personContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "m", "person.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(personContents), `import { Nationality } from "../e/nationality"`)
+
+	companyContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "entities", "company.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(companyContents), `import { Address } from "../s/address"`)
+
+	rootIndexContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "index.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(rootIndexContents), `export * as Enums from "./e"`)
+	suite.Contains(string(rootIndexContents), `export * as Models from "./m"`)
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_Bundle() {
+	suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+	defer os.RemoveAll(suite.WorkingDirPath)
+
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"bundle": "types",
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+	suite.NoError(configErr)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+	suite.FileEquals(filepath.Join(suite.WorkingDirPath, "types.d.ts"), filepath.Join(suite.TestDirPath, "ground-truth", "compile-minimal-bundle", "types.d.ts"))
+	suite.NoFileExists(filepath.Join(suite.WorkingDirPath, "index.ts"))
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_BrandedIDs() {
+	suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+	defer os.RemoveAll(suite.WorkingDirPath)
+
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"layout": map[string]any{
+			"models": "m",
+		},
+		"models": map[string]any{
+			"typeSuffix": "Model",
+			"brandedIDs": true,
+		},
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+	suite.NoError(configErr)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	personContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "m", "person-model.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(personContents), `export type PersonModelID = number & { readonly __brand: "PersonModelID" }`)
+
+	entityContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "entities", "person.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(entityContents), `import { PersonModelID } from "../m/person-model"`)
+	suite.Contains(string(entityContents), `readonly id: PersonModelID`)
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_EnumStyles() {
+	allExpectedEnumLines := map[string][]string{
+		"declareEnum": {
+			"export declare enum Nationality {",
+			"\tDE = 'German',",
+		},
+		"constEnum": {
+			"export const enum Nationality {",
+			"\tDE = 'German',",
+		},
+		"union": {
+			"export type Nationality = 'German' | 'French' | 'American'",
+		},
+		"constObject": {
+			"export declare const Nationality: {",
+			"\treadonly DE: 'German'",
+			"export type Nationality = (typeof Nationality)[keyof typeof Nationality]",
+		},
+	}
+
+	for enumStyle, allExpectedLines := range allExpectedEnumLines {
+		suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+
+		options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+			"enums": map[string]any{
+				"style": enumStyle,
+			},
+		})
+		suite.NoError(optionsErr)
+
+		config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+		suite.NoError(configErr)
+
+		compileErr := compile.MorpheToTypescript(config)
+
+		suite.NoError(compileErr)
+
+		enumContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "enums", "nationality.d.ts"))
+		suite.NoError(readErr)
+		for _, expectedLine := range allExpectedLines {
+			suite.Contains(string(enumContents), expectedLine, enumStyle)
+		}
+		suite.NotContains(string(enumContents), "export enum", enumStyle)
+
+		personContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "models", "person.d.ts"))
+		suite.NoError(readErr)
+		suite.Contains(string(personContents), `import { Nationality } from "../enums/nationality"`, enumStyle)
+		suite.Contains(string(personContents), "\tnationality: Nationality", enumStyle)
+
+		suite.Nil(os.RemoveAll(suite.WorkingDirPath))
+	}
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_EnumStyles_Zod() {
+	suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+	defer os.RemoveAll(suite.WorkingDirPath)
+
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"enums": map[string]any{
+			"style": "union",
+		},
+		"outputs": []any{"types", "zod"},
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+	suite.NoError(configErr)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	zodContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "zod", "enums", "nationality.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(zodContents), "export const Nationality = Object.freeze({\n\tDE: 'German',")
+	suite.Contains(string(zodContents), "} as const)\nexport type Nationality = (typeof Nationality)[keyof typeof Nationality]")
+	suite.Contains(string(zodContents), "export const NationalitySchema = z.nativeEnum(Nationality)")
+	suite.NotContains(string(zodContents), "export enum")
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_DeclaredEnumEntryOrder() {
+	suite.Nil(os.Mkdir(suite.WorkingDirPath, 0644))
+	defer os.RemoveAll(suite.WorkingDirPath)
+
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"enums": map[string]any{
+			"entryOrder": "declared",
+		},
+		"outputs": []any{"types", "enumHelpers"},
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+	suite.NoError(configErr)
+
+	compileErr := compile.MorpheToTypescript(config)
+
+	suite.NoError(compileErr)
+
+	nationalityContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "enums", "nationality.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(nationalityContents), "\tUS = 'American',\n\tDE = 'German',\n\tFR = 'French'\n")
+
+	universalNumberContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "enums", "universal-number.d.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(universalNumberContents), "\tPi = 3.1415926535,\n\tEuler = 2.7182818285\n")
+
+	helpersContents, readErr := os.ReadFile(filepath.Join(suite.WorkingDirPath, "enums", "nationality.helpers.ts"))
+	suite.NoError(readErr)
+	suite.Contains(string(helpersContents), "export const NationalityKeys = Object.freeze(['US', 'DE', 'FR'] as const)")
+	suite.Contains(string(helpersContents), "export const NationalityValues = Object.freeze(['American', 'German', 'French'])")
+}
+
+func (suite *PluginCompileConfigTestSuite) TestPluginMorpheCompileConfig_IdentifierHandling() {
+	options, optionsErr := cfg.DecodePluginOptions(map[string]any{
+		"identifierHandling": "rename",
+	})
+	suite.NoError(optionsErr)
+
+	config, configErr := compile.PluginMorpheCompileConfig(suite.RegistryDirPath, suite.WorkingDirPath, options)
+
+	suite.NoError(configErr)
+	suite.Equal(cfg.IdentifierHandlingRename, config.MorpheEnumsConfig.IdentifierHandling)
+	suite.Equal(cfg.IdentifierHandlingRename, config.MorpheModelsConfig.IdentifierHandling)
+	suite.Equal(cfg.IdentifierHandlingRename, config.MorpheStructuresConfig.IdentifierHandling)
+	suite.Equal(cfg.IdentifierHandlingRename, config.MorpheEntitiesConfig.IdentifierHandling)
+}
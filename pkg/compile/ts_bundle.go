@@ -0,0 +1,312 @@
+package compile
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+const defaultBundleName = "types"
+
+// TsBundle accumulates all enums and objects written through its bundle writers into a single `.d.ts` file, which is
+// rendered and written once on `Flush` after the last write.
+//
+// Relative imports between bundled files are dropped, and main object names already taken by a previously bundled
+// file (e.g. the `Person` entity after the `Person` model) are suffixed with the writer's `CollisionSuffix`.
+type TsBundle struct {
+	TargetDirPath string
+	// BundleName is the file name of the bundle without suffix, defaults to "types"
+	BundleName string
+	// EnumStyle is the form enums are declared in, defaults to `export enum`
+	EnumStyle cfg.EnumStyle
+
+	allFileKeys     []string
+	allFiles        map[string]*tsBundleFile
+	allBundledNames map[string]string
+	hasChanges      bool
+}
+
+type tsBundleFile struct {
+	dirName         string
+	mainName        string
+	bundledMainName string
+
+	enums   []*tsdef.Enum
+	objects []*tsdef.Object
+}
+
+// Flush writes the bundle file if any enum or object was added or removed since the last flush.
+func (b *TsBundle) Flush() error {
+	if !b.hasChanges {
+		return nil
+	}
+	if _, writeErr := b.write(); writeErr != nil {
+		return writeErr
+	}
+	b.hasChanges = false
+	return nil
+}
+
+// addEnum adds the enum to the bundle and returns its block as bundled so far.
+func (b *TsBundle) addEnum(dirName string, collisionSuffix string, mainEnumName string, enumDefinition *tsdef.Enum) ([]string, error) {
+	bundleFile, fileErr := b.getOrAddFile(dirName, collisionSuffix, mainEnumName)
+	if fileErr != nil {
+		return nil, fileErr
+	}
+	if nameErr := b.registerName(bundleFile, enumDefinition.Name); nameErr != nil {
+		return nil, nameErr
+	}
+	bundleFile.enums = append(bundleFile.enums, enumDefinition)
+	b.hasChanges = true
+	return b.getBundledEnumLines(bundleFile, enumDefinition)
+}
+
+// addObject adds the object to the bundle and returns its block as bundled so far.
+func (b *TsBundle) addObject(dirName string, collisionSuffix string, mainObjectName string, objectDefinition *tsdef.Object) ([]string, error) {
+	bundleFile, fileErr := b.getOrAddFile(dirName, collisionSuffix, mainObjectName)
+	if fileErr != nil {
+		return nil, fileErr
+	}
+	if nameErr := b.registerName(bundleFile, objectDefinition.Name); nameErr != nil {
+		return nil, nameErr
+	}
+	bundleFile.objects = append(bundleFile.objects, objectDefinition)
+	b.hasChanges = true
+	return b.getBundledObjectLines(bundleFile, objectDefinition)
+}
+
+func (b *TsBundle) removeFile(dirName string, mainName string) {
+	fileKey := getBundleFileKey(dirName, mainName)
+	if _, fileExists := b.allFiles[fileKey]; !fileExists {
+		return
+	}
+	delete(b.allFiles, fileKey)
+	b.hasChanges = true
+
+	remainingFileKeys := []string{}
+	for _, existingFileKey := range b.allFileKeys {
+		if existingFileKey != fileKey {
+			remainingFileKeys = append(remainingFileKeys, existingFileKey)
+		}
+	}
+	b.allFileKeys = remainingFileKeys
+
+	for bundledName, nameFileKey := range b.allBundledNames {
+		if nameFileKey == fileKey {
+			delete(b.allBundledNames, bundledName)
+		}
+	}
+}
+
+func (b *TsBundle) getOrAddFile(dirName string, collisionSuffix string, mainName string) (*tsBundleFile, error) {
+	if b.allFiles == nil {
+		b.allFiles = map[string]*tsBundleFile{}
+		b.allBundledNames = map[string]string{}
+	}
+
+	fileKey := getBundleFileKey(dirName, mainName)
+	if bundleFile, fileExists := b.allFiles[fileKey]; fileExists {
+		return bundleFile, nil
+	}
+
+	bundleFile := &tsBundleFile{
+		dirName:         dirName,
+		mainName:        mainName,
+		bundledMainName: mainName,
+	}
+	if _, mainNameTaken := b.allBundledNames[mainName]; mainNameTaken {
+		bundleFile.bundledMainName = mainName + collisionSuffix
+	}
+	if otherFileKey, bundledNameTaken := b.allBundledNames[bundleFile.bundledMainName]; bundledNameTaken {
+		return nil, ErrBundleNameCollision(bundleFile.bundledMainName, fileKey, otherFileKey)
+	}
+
+	b.allFiles[fileKey] = bundleFile
+	b.allFileKeys = append(b.allFileKeys, fileKey)
+	return bundleFile, nil
+}
+
+func (b *TsBundle) registerName(bundleFile *tsBundleFile, name string) error {
+	fileKey := getBundleFileKey(bundleFile.dirName, bundleFile.mainName)
+	bundledName := bundleFile.getBundledName(name)
+	if otherFileKey, bundledNameTaken := b.allBundledNames[bundledName]; bundledNameTaken && otherFileKey != fileKey {
+		return ErrBundleNameCollision(bundledName, fileKey, otherFileKey)
+	}
+	b.allBundledNames[bundledName] = fileKey
+	return nil
+}
+
+func (b *TsBundle) write() ([]byte, error) {
+	allBundleLines, allLinesErr := b.getAllBundleLines()
+	if allLinesErr != nil {
+		return nil, allLinesErr
+	}
+
+	bundleContents, bundleContentsErr := core.LinesToString(allBundleLines)
+	if bundleContentsErr != nil {
+		return nil, bundleContentsErr
+	}
+
+	bundleName := b.BundleName
+	if bundleName == "" {
+		bundleName = defaultBundleName
+	}
+	return tsfile.ReplaceTsDefinitionFile(b.TargetDirPath, bundleName, bundleContents)
+}
+
+func (b *TsBundle) getAllBundleLines() ([]string, error) {
+	allBundleLines := b.getExternalImportLines()
+
+	for _, fileKey := range b.allFileKeys {
+		bundleFile := b.allFiles[fileKey]
+		for _, enumDefinition := range bundleFile.enums {
+			enumLines, enumLinesErr := b.getBundledEnumLines(bundleFile, enumDefinition)
+			if enumLinesErr != nil {
+				return nil, enumLinesErr
+			}
+			allBundleLines = appendBundleBlock(allBundleLines, enumLines)
+		}
+		for _, objectDefinition := range bundleFile.objects {
+			objectLines, objectLinesErr := b.getBundledObjectLines(bundleFile, objectDefinition)
+			if objectLinesErr != nil {
+				return nil, objectLinesErr
+			}
+			allBundleLines = appendBundleBlock(allBundleLines, objectLines)
+		}
+	}
+	return allBundleLines, nil
+}
+
+func (b *TsBundle) getBundledEnumLines(bundleFile *tsBundleFile, enumDefinition *tsdef.Enum) ([]string, error) {
+	enumWriter := MorpheEnumFileWriter{
+		Style: b.EnumStyle,
+	}
+	bundledEnum := enumDefinition.DeepClone()
+	bundledEnum.Name = bundleFile.getBundledName(enumDefinition.Name)
+	return enumWriter.getAllEnumLines(bundledEnum.Name, &bundledEnum)
+}
+
+func (b *TsBundle) getBundledObjectLines(bundleFile *tsBundleFile, objectDefinition *tsdef.Object) ([]string, error) {
+	objectWriter := MorpheObjectFileWriter{}
+	bundledObject := b.getBundledObject(bundleFile, objectDefinition)
+	return objectWriter.getAllObjectLines(bundledObject.Name, &bundledObject)
+}
+
+// getExternalImportLines returns the imports of all modules outside of the bundle
+func (b *TsBundle) getExternalImportLines() []string {
+	allExternalImports := map[string]map[string]bool{}
+	for _, fileKey := range b.allFileKeys {
+		bundleFile := b.allFiles[fileKey]
+		for _, objectDefinition := range bundleFile.objects {
+			for _, objectImport := range objectDefinition.Imports {
+				if b.isBundledModule(bundleFile.dirName, objectImport.ModulePath) {
+					continue
+				}
+				if allExternalImports[objectImport.ModulePath] == nil {
+					allExternalImports[objectImport.ModulePath] = map[string]bool{}
+				}
+				for _, moduleName := range objectImport.ModuleNames {
+					allExternalImports[objectImport.ModulePath][moduleName] = true
+				}
+			}
+		}
+	}
+
+	allImportLines := []string{}
+	for _, modulePath := range core.MapKeysSorted(allExternalImports) {
+		moduleNames := core.MapKeysSorted(allExternalImports[modulePath])
+		allImportLines = append(allImportLines, fmt.Sprintf(`import { %s } from "%s"`, strings.Join(moduleNames, ", "), modulePath))
+	}
+	return allImportLines
+}
+
+func (b *TsBundle) getBundledObject(bundleFile *tsBundleFile, objectDefinition *tsdef.Object) tsdef.Object {
+	bundledObject := objectDefinition.DeepClone()
+	bundledObject.Name = bundleFile.getBundledName(objectDefinition.Name)
+	bundledObject.Imports = nil
+	if bundledObject.Alias != nil {
+		bundledObject.Alias = b.getBundledTsType(bundleFile.dirName, bundledObject.Alias)
+	}
+	for fieldIdx, objectField := range bundledObject.Fields {
+		bundledObject.Fields[fieldIdx].Type = b.getBundledTsType(bundleFile.dirName, objectField.Type)
+	}
+	return bundledObject
+}
+
+// getBundledTsType replaces all references to bundled objects and enums with their (possibly suffixed) bundled names
+func (b *TsBundle) getBundledTsType(dirName string, tsType tsdef.TsType) tsdef.TsType {
+	switch typedTsType := tsType.(type) {
+	case tsdef.TsTypeObject:
+		bundleFile, isBundled := b.getFileForModule(dirName, typedTsType.ModulePath)
+		if !isBundled {
+			return typedTsType
+		}
+		return tsdef.TsTypeObject{
+			Name: bundleFile.getBundledName(typedTsType.Name),
+		}
+	case tsdef.TsTypeArray:
+		return tsdef.TsTypeArray{
+			ValueType: b.getBundledTsType(dirName, typedTsType.ValueType),
+		}
+	case tsdef.TsTypeOptional:
+		return tsdef.TsTypeOptional{
+			ValueType: b.getBundledTsType(dirName, typedTsType.ValueType),
+		}
+	case tsdef.TsTypeBranded:
+		return tsdef.TsTypeBranded{
+			ValueType: b.getBundledTsType(dirName, typedTsType.ValueType),
+			Brand:     typedTsType.Brand,
+		}
+	case tsdef.TsTypeNarrowed:
+		return tsdef.TsTypeNarrowed{
+			ValueType: b.getBundledTsType(dirName, typedTsType.ValueType),
+			FieldName: typedTsType.FieldName,
+			FieldType: b.getBundledTsType(dirName, typedTsType.FieldType),
+		}
+	case tsdef.TsTypeUnion:
+		bundledUnion := tsdef.TsTypeUnion{}
+		for _, unionType := range typedTsType.Types {
+			bundledUnion.Types = append(bundledUnion.Types, b.getBundledTsType(dirName, unionType))
+		}
+		return bundledUnion
+	}
+	return tsType
+}
+
+func (b *TsBundle) isBundledModule(dirName string, modulePath string) bool {
+	_, isBundled := b.getFileForModule(dirName, modulePath)
+	return isBundled
+}
+
+func (b *TsBundle) getFileForModule(dirName string, modulePath string) (*tsBundleFile, bool) {
+	if !strings.HasPrefix(modulePath, ".") {
+		return nil, false
+	}
+	bundleFile, fileExists := b.allFiles[path.Join(dirName, modulePath)]
+	return bundleFile, fileExists
+}
+
+func (f *tsBundleFile) getBundledName(name string) string {
+	if f.bundledMainName == f.mainName || !strings.HasPrefix(name, f.mainName) {
+		return name
+	}
+	return f.bundledMainName + strings.TrimPrefix(name, f.mainName)
+}
+
+// getBundleFileKey mirrors the relative module path the file would have in a directory tree output
+func getBundleFileKey(dirName string, mainName string) string {
+	return path.Join(dirName, strcase.ToKebabCaseLower(mainName))
+}
+
+func appendBundleBlock(allBundleLines []string, blockLines []string) []string {
+	if len(allBundleLines) > 0 {
+		allBundleLines = append(allBundleLines, "")
+	}
+	return append(allBundleLines, blockLines...)
+}
@@ -0,0 +1,110 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const typeGuardModuleSuffix = ".guard"
+
+func getTypeGuardName(typeName string) string {
+	return "is" + typeName
+}
+
+func getTypeGuardModulePath(modulePath string) string {
+	return modulePath + typeGuardModuleSuffix
+}
+
+// getTypeGuardCheck renders a boolean TS expression which narrows the value expression to the given TsType.
+//
+// The depth is used to keep the item names of nested array checks unique.
+func getTypeGuardCheck(tsType tsdef.TsType, valueSyntax string, depth int) string {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return fmt.Sprintf("(%s === undefined || %s)", valueSyntax, getTypeGuardCheck(typedType.ValueType, valueSyntax, depth))
+	case tsdef.TsTypeArray:
+		itemSyntax := "item"
+		if depth > 0 {
+			itemSyntax = fmt.Sprintf("item%d", depth)
+		}
+		itemCheck := getTypeGuardCheck(typedType.ValueType, itemSyntax, depth+1)
+		return fmt.Sprintf("(Array.isArray(%s) && %s.every((%s) => %s))", valueSyntax, valueSyntax, itemSyntax, itemCheck)
+	case tsdef.TsTypeUnion:
+		if len(typedType.Types) == 1 {
+			return getTypeGuardCheck(typedType.Types[0], valueSyntax, depth)
+		}
+		allUnionChecks := []string{}
+		for _, unionType := range typedType.Types {
+			allUnionChecks = append(allUnionChecks, getTypeGuardCheck(unionType, valueSyntax, depth))
+		}
+		return "(" + strings.Join(allUnionChecks, " || ") + ")"
+	case tsdef.TsTypeObject:
+		if typedType == tsdef.TsTypeDate {
+			return fmt.Sprintf("%s instanceof Date", valueSyntax)
+		}
+		if isExternalTsTypeObject(typedType) {
+			return "true"
+		}
+		return fmt.Sprintf("%s(%s)", getTypeGuardName(typedType.Name), valueSyntax)
+	case tsdef.TsTypeBranded:
+		return getTypeGuardCheck(typedType.ValueType, valueSyntax, depth)
+	case tsdef.TsTypeNarrowed:
+		fieldSyntax := fmt.Sprintf(`(%s as Record<string, unknown>)["%s"]`, valueSyntax, typedType.FieldName)
+		return fmt.Sprintf("(%s && %s)", getTypeGuardCheck(typedType.ValueType, valueSyntax, depth), getTypeGuardCheck(typedType.FieldType, fieldSyntax, depth))
+	case tsdef.TsTypeStringLiteral:
+		return fmt.Sprintf("%s === %s", valueSyntax, typedType.GetSyntax())
+	case tsdef.TsTypePrimitive:
+		return getTypeGuardPrimitiveCheck(typedType, valueSyntax)
+	default:
+		return "true"
+	}
+}
+
+func getTypeGuardPrimitiveCheck(primitiveType tsdef.TsTypePrimitive, valueSyntax string) string {
+	switch primitiveType.Syntax {
+	case "string", "number", "boolean", "bigint":
+		return fmt.Sprintf(`typeof %s === "%s"`, valueSyntax, primitiveType.Syntax)
+	case "null", "undefined":
+		return fmt.Sprintf("%s === %s", valueSyntax, primitiveType.Syntax)
+	case "never":
+		return "false"
+	default:
+		return "true"
+	}
+}
+
+func getObjectTypeGuardLines(objectDefinition *tsdef.Object) []string {
+	if objectDefinition.Alias != nil {
+		return []string{
+			fmt.Sprintf("export function %s(value: unknown): value is %s {", getTypeGuardName(objectDefinition.Name), objectDefinition.Name),
+			"\treturn " + getTypeGuardCheck(objectDefinition.Alias, "value", 0),
+			"}",
+		}
+	}
+
+	allGuardLines := []string{
+		fmt.Sprintf("export function %s(value: unknown): value is %s {", getTypeGuardName(objectDefinition.Name), objectDefinition.Name),
+		"\tif (typeof value !== \"object\" || value === null) {",
+		"\t\treturn false",
+		"\t}",
+	}
+	if len(objectDefinition.Fields) == 0 {
+		allGuardLines = append(allGuardLines, "\treturn true", "}")
+		return allGuardLines
+	}
+
+	allGuardLines = append(allGuardLines, "\tconst record = value as Record<string, unknown>")
+	for fieldIdx, objectField := range objectDefinition.Fields {
+		fieldSyntax := "record[" + tsdef.GetTsStringLiteralSyntax(getTsFieldName(objectField.Name), tsdef.TsLiteralQuoteDouble) + "]"
+		fieldCheck := getTypeGuardCheck(objectField.Type, fieldSyntax, 0)
+		if fieldIdx == 0 {
+			allGuardLines = append(allGuardLines, "\treturn "+fieldCheck)
+			continue
+		}
+		allGuardLines = append(allGuardLines, "\t\t&& "+fieldCheck)
+	}
+	allGuardLines = append(allGuardLines, "}")
+	return allGuardLines
+}
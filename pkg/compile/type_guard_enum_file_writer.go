@@ -0,0 +1,61 @@
+package compile
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// TypeGuardEnumFileWriter writes runtime type guard functions (`isNationality(value): value is Nationality`) into `.guard.ts` files.
+//
+// Guard files are written next to the enum definitions they import from, so the writer should target the same directory
+// as the enum definition writer.
+type TypeGuardEnumFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *TypeGuardEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	allGuardLines, allLinesErr := w.getAllGuardLines(enumName, enumDefinition)
+	if allLinesErr != nil {
+		return nil, allLinesErr
+	}
+
+	guardFileContents, guardContentsErr := core.LinesToString(allGuardLines)
+	if guardContentsErr != nil {
+		return nil, guardContentsErr
+	}
+
+	return tsfile.WriteTsGuardFile(w.TargetDirPath, enumName, guardFileContents)
+}
+
+func (w *TypeGuardEnumFileWriter) getAllGuardLines(enumName string, enumDefinition *tsdef.Enum) ([]string, error) {
+	allGuardLines := []string{
+		fmt.Sprintf(`import type { %s } from "./%s"`, enumDefinition.Name, strcase.ToKebabCaseLower(enumName)),
+		"",
+		fmt.Sprintf("export function %s(value: unknown): value is %s {", getTypeGuardName(enumDefinition.Name), enumDefinition.Name),
+	}
+	for entryIdx, enumEntry := range enumDefinition.Entries {
+		entryValue, entryValueErr := getEnumValueLiteral(enumEntry.Value)
+		if entryValueErr != nil {
+			return nil, ErrInvalidEnumEntryValue(enumDefinition.Name, enumEntry.Name, entryValueErr)
+		}
+		entryCheck := "value === " + entryValue
+		if entryIdx == 0 {
+			allGuardLines = append(allGuardLines, "\treturn "+entryCheck)
+			continue
+		}
+		allGuardLines = append(allGuardLines, "\t\t|| "+entryCheck)
+	}
+	if len(enumDefinition.Entries) == 0 {
+		allGuardLines = append(allGuardLines, "\treturn false")
+	}
+	allGuardLines = append(allGuardLines, "}")
+	return allGuardLines, nil
+}
+
+func (w *TypeGuardEnumFileWriter) ClearFile(enumName string) error {
+	return tsfile.ClearTsGuardFile(w.TargetDirPath, enumName)
+}
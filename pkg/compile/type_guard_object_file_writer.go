@@ -0,0 +1,80 @@
+package compile
+
+import (
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// TypeGuardObjectFileWriter writes runtime type guard functions (`isPerson(value): value is Person`) into `.guard.ts` files.
+//
+// Guard files are written next to the type definitions they import from, so the writer should target the same directory
+// as the definition writer. All objects sharing a main object are collected into one file, which is rewritten on every write.
+type TypeGuardObjectFileWriter struct {
+	TargetDirPath string
+
+	allFileObjects map[string][]*tsdef.Object
+}
+
+func (w *TypeGuardObjectFileWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	if w.allFileObjects == nil {
+		w.allFileObjects = map[string][]*tsdef.Object{}
+	}
+	w.allFileObjects[mainObjectName] = append(w.allFileObjects[mainObjectName], objectDefinition)
+
+	allGuardLines := w.getAllGuardLines(mainObjectName, w.allFileObjects[mainObjectName])
+	guardFileContents, guardContentsErr := core.LinesToString(allGuardLines)
+	if guardContentsErr != nil {
+		return nil, guardContentsErr
+	}
+
+	return tsfile.WriteTsGuardFile(w.TargetDirPath, mainObjectName, guardFileContents)
+}
+
+func (w *TypeGuardObjectFileWriter) getAllGuardLines(mainObjectName string, allFileObjects []*tsdef.Object) []string {
+	allGuardLines := w.getAllImportLines(mainObjectName, allFileObjects)
+	for _, fileObject := range allFileObjects {
+		allGuardLines = append(allGuardLines, "")
+		allGuardLines = append(allGuardLines, getObjectTypeGuardLines(fileObject)...)
+	}
+	return allGuardLines
+}
+
+func (w *TypeGuardObjectFileWriter) getAllImportLines(mainObjectName string, allFileObjects []*tsdef.Object) []string {
+	ownModulePath := "./" + strcase.ToKebabCaseLower(mainObjectName)
+
+	allTypeNames := []string{}
+	for _, fileObject := range allFileObjects {
+		allTypeNames = append(allTypeNames, fileObject.Name)
+	}
+	allImportLines := []string{
+		`import type { ` + strings.Join(allTypeNames, ", ") + ` } from "` + ownModulePath + `"`,
+	}
+
+	guardImportsMap := map[string]tsdef.ObjectImport{}
+	for _, fileObject := range allFileObjects {
+		for _, objectImport := range fileObject.Imports {
+			if objectImport.ModulePath == ownModulePath || isExternalModulePath(objectImport.ModulePath) {
+				continue
+			}
+			guardImportsMap[objectImport.ModulePath] = objectImport
+		}
+	}
+
+	for _, modulePath := range core.MapKeysSorted(guardImportsMap) {
+		guardNames := []string{}
+		for _, moduleName := range guardImportsMap[modulePath].ModuleNames {
+			guardNames = append(guardNames, getTypeGuardName(moduleName))
+		}
+		allImportLines = append(allImportLines, `import { `+strings.Join(guardNames, ", ")+` } from "`+getTypeGuardModulePath(modulePath)+`"`)
+	}
+	return allImportLines
+}
+
+func (w *TypeGuardObjectFileWriter) ClearFile(mainObjectName string) error {
+	delete(w.allFileObjects, mainObjectName)
+	return tsfile.ClearTsGuardFile(w.TargetDirPath, mainObjectName)
+}
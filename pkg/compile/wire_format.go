@@ -0,0 +1,410 @@
+package compile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const wireFormatModuleSuffix = ".wire"
+
+func getWireFormatTypeName(typeName string) string {
+	return typeName + "JSON"
+}
+
+func getWireFormatFromJSONName(typeName string) string {
+	return "from" + typeName + "JSON"
+}
+
+func getWireFormatToJSONName(typeName string) string {
+	return "to" + typeName + "JSON"
+}
+
+func getWireFormatModulePath(modulePath string) string {
+	return modulePath + wireFormatModuleSuffix
+}
+
+// getWireFormatTsType replaces all dates with ISO strings and all generated types with their wire format variants,
+// which are resolved from the sibling `.wire` modules.
+func getWireFormatTsType(tsType tsdef.TsType) tsdef.TsType {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return tsdef.TsTypeOptional{
+			ValueType: getWireFormatTsType(typedType.ValueType),
+		}
+	case tsdef.TsTypeArray:
+		return tsdef.TsTypeArray{
+			ValueType: getWireFormatTsType(typedType.ValueType),
+		}
+	case tsdef.TsTypeUnion:
+		wireUnion := tsdef.TsTypeUnion{}
+		for _, unionType := range typedType.Types {
+			wireUnion.Types = append(wireUnion.Types, getWireFormatTsType(unionType))
+		}
+		return wireUnion
+	case tsdef.TsTypeBranded:
+		return tsdef.TsTypeBranded{
+			ValueType: getWireFormatTsType(typedType.ValueType),
+			Brand:     typedType.Brand,
+		}
+	case tsdef.TsTypeNarrowed:
+		return tsdef.TsTypeNarrowed{
+			ValueType: getWireFormatTsType(typedType.ValueType),
+			FieldName: typedType.FieldName,
+			FieldType: getWireFormatTsType(typedType.FieldType),
+		}
+	case tsdef.TsTypeObject:
+		if typedType == tsdef.TsTypeDate {
+			return tsdef.TsTypeString
+		}
+		if isExternalTsTypeObject(typedType) {
+			return typedType
+		}
+		return tsdef.TsTypeObject{
+			Name: getWireFormatTypeName(typedType.Name),
+		}
+	}
+	return tsType
+}
+
+// needsWireFormatConversion reports whether values of the type differ between their wire format and runtime representation.
+//
+// Generated types are always converted through their own converter, since they may contain dates.
+func needsWireFormatConversion(tsType tsdef.TsType) bool {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return needsWireFormatConversion(typedType.ValueType)
+	case tsdef.TsTypeArray:
+		return needsWireFormatConversion(typedType.ValueType)
+	case tsdef.TsTypeUnion:
+		for _, unionType := range typedType.Types {
+			if needsWireFormatConversion(unionType) {
+				return true
+			}
+		}
+		return false
+	case tsdef.TsTypeBranded:
+		return needsWireFormatConversion(typedType.ValueType)
+	case tsdef.TsTypeNarrowed:
+		return needsWireFormatConversion(typedType.ValueType)
+	case tsdef.TsTypeObject:
+		return !isExternalTsTypeObject(typedType)
+	}
+	return false
+}
+
+// wireFormatDiscriminator is the field telling the member types of a polymorphic union apart, e.g. `json.commentableType`
+// with the values `"Person"` and `"Company"`, in the order of the union types.
+type wireFormatDiscriminator struct {
+	syntax        string
+	allTypeValues []string
+}
+
+// getWireFormatConversion renders the TS expression converting the value expression from (or to) its wire format.
+//
+// Unions of several convertible types (e.g. polymorphic relations) are converted by dispatching on the discriminator.
+func getWireFormatConversion(tsType tsdef.TsType, valueSyntax string, toJSON bool, depth int, discriminator *wireFormatDiscriminator) (string, error) {
+	if !needsWireFormatConversion(tsType) {
+		return valueSyntax, nil
+	}
+
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		valueConversion, conversionErr := getWireFormatConversion(typedType.ValueType, valueSyntax, toJSON, depth, discriminator)
+		if conversionErr != nil {
+			return "", conversionErr
+		}
+		return fmt.Sprintf("(%s === undefined ? undefined : %s)", valueSyntax, valueConversion), nil
+	case tsdef.TsTypeArray:
+		itemSyntax := "item"
+		if depth > 0 {
+			itemSyntax = fmt.Sprintf("item%d", depth)
+		}
+		itemConversion, conversionErr := getWireFormatConversion(typedType.ValueType, itemSyntax, toJSON, depth+1, discriminator)
+		if conversionErr != nil {
+			return "", conversionErr
+		}
+		return fmt.Sprintf("%s.map((%s) => %s)", valueSyntax, itemSyntax, itemConversion), nil
+	case tsdef.TsTypeUnion:
+		if valueType, isNullable := getNullableValueType(typedType); isNullable {
+			valueConversion, conversionErr := getWireFormatConversion(valueType, valueSyntax, toJSON, depth, discriminator)
+			if conversionErr != nil {
+				return "", conversionErr
+			}
+			return fmt.Sprintf("(%s === null ? null : %s)", valueSyntax, valueConversion), nil
+		}
+		return getWireFormatUnionConversion(typedType, valueSyntax, toJSON, depth, discriminator)
+	case tsdef.TsTypeBranded:
+		valueConversion, conversionErr := getWireFormatConversion(typedType.ValueType, valueSyntax, toJSON, depth, discriminator)
+		if conversionErr != nil {
+			return "", conversionErr
+		}
+		return fmt.Sprintf("(%s as %s)", valueConversion, getWireFormatTargetSyntax(tsType, toJSON)), nil
+	case tsdef.TsTypeNarrowed:
+		valueConversion, conversionErr := getWireFormatConversion(typedType.ValueType, valueSyntax, toJSON, depth, discriminator)
+		if conversionErr != nil {
+			return "", conversionErr
+		}
+		return fmt.Sprintf("(%s as %s)", valueConversion, getWireFormatTargetSyntax(tsType, toJSON)), nil
+	case tsdef.TsTypeObject:
+		if typedType == tsdef.TsTypeDate && toJSON {
+			return valueSyntax + ".toISOString()", nil
+		}
+		if typedType == tsdef.TsTypeDate {
+			return fmt.Sprintf("new Date(%s)", valueSyntax), nil
+		}
+		if toJSON {
+			return fmt.Sprintf("%s(%s)", getWireFormatToJSONName(typedType.Name), valueSyntax), nil
+		}
+		return fmt.Sprintf("%s(%s)", getWireFormatFromJSONName(typedType.Name), valueSyntax), nil
+	}
+	return valueSyntax, nil
+}
+
+// getWireFormatUnionConversion converts each member type of the union when the discriminator holds its value, e.g.
+// `json.commentableType === "Person" ? fromPersonJSON(json.commentable as PersonJSON) : fromCompanyJSON(...)`.
+func getWireFormatUnionConversion(unionType tsdef.TsTypeUnion, valueSyntax string, toJSON bool, depth int, discriminator *wireFormatDiscriminator) (string, error) {
+	if discriminator == nil || len(discriminator.allTypeValues) != len(unionType.Types) {
+		return "", ErrNoWireFormatDiscriminator(unionType.GetSyntax())
+	}
+
+	allMemberConversions := []string{}
+	for _, memberType := range unionType.Types {
+		memberSyntax := fmt.Sprintf("%s as %s", valueSyntax, getWireFormatTargetSyntax(memberType, !toJSON))
+		if memberObjectType, isObject := memberType.(tsdef.TsTypeObject); !isObject || memberObjectType == tsdef.TsTypeDate {
+			memberSyntax = "(" + memberSyntax + ")"
+		}
+		memberConversion, conversionErr := getWireFormatConversion(memberType, memberSyntax, toJSON, depth, nil)
+		if conversionErr != nil {
+			return "", conversionErr
+		}
+		allMemberConversions = append(allMemberConversions, memberConversion)
+	}
+
+	lastMemberIdx := len(allMemberConversions) - 1
+	unionConversion := allMemberConversions[lastMemberIdx]
+	for memberIdx := lastMemberIdx - 1; memberIdx >= 0; memberIdx-- {
+		typeValueSyntax := tsdef.TsTypeStringLiteral{Value: discriminator.allTypeValues[memberIdx]}.GetSyntax()
+		unionConversion = fmt.Sprintf("%s === %s ? %s : %s", discriminator.syntax, typeValueSyntax, allMemberConversions[memberIdx], unionConversion)
+	}
+	return "(" + unionConversion + ")", nil
+}
+
+// getWireFormatFieldDiscriminator resolves the discriminator of a polymorphic relation field, which is the sibling
+// `commentableType` field of the `commentable` (or `commentables`) field.
+func getWireFormatFieldDiscriminator(objectDefinition *tsdef.Object, objectField tsdef.ObjectField, objectSyntax string) *wireFormatDiscriminator {
+	fieldName := getTsFieldName(objectField.Name)
+	allDiscriminatorNames := []string{
+		fieldName + "Type",
+		strings.TrimSuffix(fieldName, "s") + "Type",
+	}
+	for _, siblingField := range objectDefinition.Fields {
+		siblingName := getTsFieldName(siblingField.Name)
+		if !slices.Contains(allDiscriminatorNames, siblingName) {
+			continue
+		}
+		allTypeValues := getAllStringLiteralValues(siblingField.Type)
+		if len(allTypeValues) == 0 {
+			continue
+		}
+		return &wireFormatDiscriminator{
+			syntax:        tsdef.GetTsPropertyAccessSyntax(objectSyntax, siblingName, tsdef.TsLiteralQuoteDouble),
+			allTypeValues: allTypeValues,
+		}
+	}
+	return nil
+}
+
+// getWireFormatAliasDiscriminator resolves the discriminator of a discriminated union alias (see
+// getPolymorphicUnionObjectTypes), which is the `type` field of its member types written to the same file.
+func getWireFormatAliasDiscriminator(aliasType tsdef.TsType, allFileObjects []*tsdef.Object, objectSyntax string) *wireFormatDiscriminator {
+	unionType, isUnion := aliasType.(tsdef.TsTypeUnion)
+	if !isUnion {
+		return nil
+	}
+
+	allTypeValues := []string{}
+	for _, memberType := range unionType.Types {
+		memberObjectType, isObject := memberType.(tsdef.TsTypeObject)
+		if !isObject {
+			return nil
+		}
+		memberObjectIdx := slices.IndexFunc(allFileObjects, func(fileObject *tsdef.Object) bool {
+			return fileObject.Name == memberObjectType.Name
+		})
+		if memberObjectIdx == -1 {
+			return nil
+		}
+		memberTypeValue := ""
+		for _, memberField := range allFileObjects[memberObjectIdx].Fields {
+			if memberField.Name != polymorphicUnionTypeFieldName {
+				continue
+			}
+			if allMemberTypeValues := getAllStringLiteralValues(memberField.Type); len(allMemberTypeValues) == 1 {
+				memberTypeValue = allMemberTypeValues[0]
+			}
+		}
+		if memberTypeValue == "" {
+			return nil
+		}
+		allTypeValues = append(allTypeValues, memberTypeValue)
+	}
+
+	return &wireFormatDiscriminator{
+		syntax:        tsdef.GetTsPropertyAccessSyntax(objectSyntax, polymorphicUnionTypeFieldName, tsdef.TsLiteralQuoteDouble),
+		allTypeValues: allTypeValues,
+	}
+}
+
+// getAllStringLiteralValues returns the values of a (possibly optional) string literal or union of string literals.
+func getAllStringLiteralValues(tsType tsdef.TsType) []string {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return getAllStringLiteralValues(typedType.ValueType)
+	case tsdef.TsTypeStringLiteral:
+		return []string{typedType.Value}
+	case tsdef.TsTypeUnion:
+		allValues := []string{}
+		for _, unionType := range typedType.Types {
+			literalType, isLiteral := unionType.(tsdef.TsTypeStringLiteral)
+			if !isLiteral {
+				return nil
+			}
+			allValues = append(allValues, literalType.Value)
+		}
+		return allValues
+	}
+	return nil
+}
+
+// getAllWireFormatCastTypeNames collects the generated types referenced by the casts of getWireFormatConversion, by
+// module path, so they can be imported next to the wire format types.
+func getAllWireFormatCastTypeNames(tsType tsdef.TsType, allCastTypeNames map[string][]string) {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		getAllWireFormatCastTypeNames(typedType.ValueType, allCastTypeNames)
+	case tsdef.TsTypeArray:
+		getAllWireFormatCastTypeNames(typedType.ValueType, allCastTypeNames)
+	case tsdef.TsTypeBranded:
+		getAllWireFormatCastTypeNames(typedType.ValueType, allCastTypeNames)
+	case tsdef.TsTypeUnion:
+		if valueType, isNullable := getNullableValueType(typedType); isNullable {
+			getAllWireFormatCastTypeNames(valueType, allCastTypeNames)
+			return
+		}
+		if !needsWireFormatConversion(typedType) {
+			return
+		}
+		for _, unionType := range typedType.Types {
+			addWireFormatCastTypeName(unionType, allCastTypeNames)
+		}
+	case tsdef.TsTypeNarrowed:
+		addWireFormatCastTypeName(typedType.ValueType, allCastTypeNames)
+	}
+}
+
+func addWireFormatCastTypeName(tsType tsdef.TsType, allCastTypeNames map[string][]string) {
+	objectType, isObject := tsType.(tsdef.TsTypeObject)
+	if !isObject || objectType.ModulePath == "" || isExternalTsTypeObject(objectType) {
+		return
+	}
+	if !slices.Contains(allCastTypeNames[objectType.ModulePath], objectType.Name) {
+		allCastTypeNames[objectType.ModulePath] = append(allCastTypeNames[objectType.ModulePath], objectType.Name)
+	}
+}
+
+func getWireFormatTargetSyntax(tsType tsdef.TsType, toJSON bool) string {
+	if toJSON {
+		return getWireFormatTsType(tsType).GetSyntax()
+	}
+	return tsType.GetSyntax()
+}
+
+// getNullableValueType returns the only non-null type of a `T | null` union
+func getNullableValueType(unionType tsdef.TsTypeUnion) (tsdef.TsType, bool) {
+	if len(unionType.Types) != 2 {
+		return nil, false
+	}
+	for typeIdx, memberType := range unionType.Types {
+		if memberType == tsdef.TsTypeNull {
+			return unionType.Types[1-typeIdx], true
+		}
+	}
+	return nil, false
+}
+
+// getObjectWireFormatLines renders the `PersonJSON` type of an object along with its `fromPersonJSON` and `toPersonJSON` converters.
+func getObjectWireFormatLines(objectDefinition *tsdef.Object, allFileObjects []*tsdef.Object) ([]string, error) {
+	wireTypeName := getWireFormatTypeName(objectDefinition.Name)
+	fromJSONSignature := fmt.Sprintf("export function %s(json: %s): %s {", getWireFormatFromJSONName(objectDefinition.Name), wireTypeName, objectDefinition.Name)
+	toJSONSignature := fmt.Sprintf("export function %s(value: %s): %s {", getWireFormatToJSONName(objectDefinition.Name), objectDefinition.Name, wireTypeName)
+
+	if objectDefinition.Alias != nil {
+		fromJSONDiscriminator := getWireFormatAliasDiscriminator(objectDefinition.Alias, allFileObjects, "json")
+		fromJSONConversion, fromJSONErr := getWireFormatConversion(objectDefinition.Alias, "json", false, 0, fromJSONDiscriminator)
+		if fromJSONErr != nil {
+			return nil, fromJSONErr
+		}
+		toJSONDiscriminator := getWireFormatAliasDiscriminator(objectDefinition.Alias, allFileObjects, "value")
+		toJSONConversion, toJSONErr := getWireFormatConversion(objectDefinition.Alias, "value", true, 0, toJSONDiscriminator)
+		if toJSONErr != nil {
+			return nil, toJSONErr
+		}
+		return []string{
+			fmt.Sprintf("export type %s = %s", wireTypeName, getWireFormatTsType(objectDefinition.Alias).GetSyntax()),
+			"",
+			fromJSONSignature,
+			"\treturn " + fromJSONConversion,
+			"}",
+			"",
+			toJSONSignature,
+			"\treturn " + toJSONConversion,
+			"}",
+		}, nil
+	}
+
+	allWireLines := []string{
+		fmt.Sprintf("export type %s = {", wireTypeName),
+	}
+	allFromJSONLines := []string{
+		fromJSONSignature,
+		"\treturn {",
+	}
+	allToJSONLines := []string{
+		toJSONSignature,
+		"\treturn {",
+	}
+	for _, objectField := range objectDefinition.Fields {
+		fieldName := getTsFieldName(objectField.Name)
+		propertyName := tsdef.GetTsPropertyNameSyntax(fieldName, tsdef.TsLiteralQuoteDouble)
+		wireFieldName := propertyName
+		if objectField.Readonly {
+			wireFieldName = "readonly " + wireFieldName
+		}
+		if objectField.Type.IsOptional() {
+			wireFieldName += "?"
+		}
+		allWireLines = append(allWireLines, fmt.Sprintf("\t%s: %s", wireFieldName, getWireFormatTsType(objectField.Type).GetSyntax()))
+
+		fromJSONDiscriminator := getWireFormatFieldDiscriminator(objectDefinition, objectField, "json")
+		fromJSONConversion, fromJSONErr := getWireFormatConversion(objectField.Type, tsdef.GetTsPropertyAccessSyntax("json", fieldName, tsdef.TsLiteralQuoteDouble), false, 0, fromJSONDiscriminator)
+		if fromJSONErr != nil {
+			return nil, fromJSONErr
+		}
+		allFromJSONLines = append(allFromJSONLines, fmt.Sprintf("\t\t%s: %s,", propertyName, fromJSONConversion))
+		toJSONDiscriminator := getWireFormatFieldDiscriminator(objectDefinition, objectField, "value")
+		toJSONConversion, toJSONErr := getWireFormatConversion(objectField.Type, tsdef.GetTsPropertyAccessSyntax("value", fieldName, tsdef.TsLiteralQuoteDouble), true, 0, toJSONDiscriminator)
+		if toJSONErr != nil {
+			return nil, toJSONErr
+		}
+		allToJSONLines = append(allToJSONLines, fmt.Sprintf("\t\t%s: %s,", propertyName, toJSONConversion))
+	}
+	allWireLines = append(allWireLines, "}", "")
+	allFromJSONLines = append(allFromJSONLines, "\t}", "}", "")
+	allToJSONLines = append(allToJSONLines, "\t}", "}")
+
+	allWireLines = append(allWireLines, allFromJSONLines...)
+	return append(allWireLines, allToJSONLines...), nil
+}
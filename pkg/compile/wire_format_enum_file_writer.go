@@ -0,0 +1,50 @@
+package compile
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// WireFormatEnumFileWriter writes the JSON wire format of enums (`NationalityJSON`) along with identity converter
+// functions into `.wire.ts` files, so object converters can treat enums like any other referenced type.
+//
+// Wire format files are written next to the enum definitions they import from, so the writer should target the same
+// directory as the enum definition writer.
+type WireFormatEnumFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *WireFormatEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	allWireLines := w.getAllWireLines(enumName, enumDefinition)
+	wireFileContents, wireContentsErr := core.LinesToString(allWireLines)
+	if wireContentsErr != nil {
+		return nil, wireContentsErr
+	}
+
+	return tsfile.WriteTsWireFile(w.TargetDirPath, enumName, wireFileContents)
+}
+
+func (w *WireFormatEnumFileWriter) getAllWireLines(enumName string, enumDefinition *tsdef.Enum) []string {
+	wireTypeName := getWireFormatTypeName(enumDefinition.Name)
+	return []string{
+		fmt.Sprintf(`import type { %s } from "./%s"`, enumDefinition.Name, strcase.ToKebabCaseLower(enumName)),
+		"",
+		fmt.Sprintf("export type %s = %s", wireTypeName, enumDefinition.Name),
+		"",
+		fmt.Sprintf("export function %s(json: %s): %s {", getWireFormatFromJSONName(enumDefinition.Name), wireTypeName, enumDefinition.Name),
+		"\treturn json",
+		"}",
+		"",
+		fmt.Sprintf("export function %s(value: %s): %s {", getWireFormatToJSONName(enumDefinition.Name), enumDefinition.Name, wireTypeName),
+		"\treturn value",
+		"}",
+	}
+}
+
+func (w *WireFormatEnumFileWriter) ClearFile(enumName string) error {
+	return tsfile.ClearTsWireFile(w.TargetDirPath, enumName)
+}
@@ -0,0 +1,114 @@
+package compile
+
+import (
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// WireFormatObjectFileWriter writes the JSON wire format of objects (`PersonJSON`), where dates are ISO strings, along
+// with converter functions (`fromPersonJSON`, `toPersonJSON`) into `.wire.ts` files.
+//
+// Wire format files are written next to the type definitions they import from, so the writer should target the same
+// directory as the definition writer. All objects sharing a main object are collected into one file, which is rewritten
+// on every write.
+type WireFormatObjectFileWriter struct {
+	TargetDirPath string
+
+	allFileObjects map[string][]*tsdef.Object
+}
+
+func (w *WireFormatObjectFileWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	if w.allFileObjects == nil {
+		w.allFileObjects = map[string][]*tsdef.Object{}
+	}
+	w.allFileObjects[mainObjectName] = append(w.allFileObjects[mainObjectName], objectDefinition)
+
+	allWireLines, wireLinesErr := w.getAllWireLines(mainObjectName, w.allFileObjects[mainObjectName])
+	if wireLinesErr != nil {
+		return nil, wireLinesErr
+	}
+	wireFileContents, wireContentsErr := core.LinesToString(allWireLines)
+	if wireContentsErr != nil {
+		return nil, wireContentsErr
+	}
+
+	return tsfile.WriteTsWireFile(w.TargetDirPath, mainObjectName, wireFileContents)
+}
+
+func (w *WireFormatObjectFileWriter) getAllWireLines(mainObjectName string, allFileObjects []*tsdef.Object) ([]string, error) {
+	allWireLines := w.getAllImportLines(mainObjectName, allFileObjects)
+	for _, fileObject := range allFileObjects {
+		objectWireLines, objectWireErr := getObjectWireFormatLines(fileObject, allFileObjects)
+		if objectWireErr != nil {
+			return nil, objectWireErr
+		}
+		allWireLines = append(allWireLines, "")
+		allWireLines = append(allWireLines, objectWireLines...)
+	}
+	return allWireLines, nil
+}
+
+func (w *WireFormatObjectFileWriter) getAllImportLines(mainObjectName string, allFileObjects []*tsdef.Object) []string {
+	ownModulePath := "./" + strcase.ToKebabCaseLower(mainObjectName)
+
+	allTypeNames := []string{}
+	for _, fileObject := range allFileObjects {
+		allTypeNames = append(allTypeNames, fileObject.Name)
+	}
+	allImportLines := []string{
+		`import type { ` + strings.Join(allTypeNames, ", ") + ` } from "` + ownModulePath + `"`,
+	}
+
+	wireImportsMap := map[string]tsdef.ObjectImport{}
+	externalImportsMap := map[string]tsdef.ObjectImport{}
+	castTypeNamesMap := map[string][]string{}
+	for _, fileObject := range allFileObjects {
+		if fileObject.Alias != nil {
+			getAllWireFormatCastTypeNames(fileObject.Alias, castTypeNamesMap)
+		}
+		for _, objectField := range fileObject.Fields {
+			getAllWireFormatCastTypeNames(objectField.Type, castTypeNamesMap)
+		}
+		for _, objectImport := range fileObject.Imports {
+			if objectImport.ModulePath == ownModulePath {
+				continue
+			}
+			if isExternalModulePath(objectImport.ModulePath) {
+				externalImportsMap[objectImport.ModulePath] = objectImport
+				continue
+			}
+			wireImportsMap[objectImport.ModulePath] = objectImport
+		}
+	}
+
+	for _, modulePath := range core.MapKeysSorted(castTypeNamesMap) {
+		if modulePath == ownModulePath {
+			continue
+		}
+		allImportLines = append(allImportLines, `import type { `+strings.Join(castTypeNamesMap[modulePath], ", ")+` } from "`+modulePath+`"`)
+	}
+	for _, modulePath := range core.MapKeysSorted(externalImportsMap) {
+		allImportLines = append(allImportLines, `import type { `+strings.Join(externalImportsMap[modulePath].ModuleNames, ", ")+` } from "`+modulePath+`"`)
+	}
+	for _, modulePath := range core.MapKeysSorted(wireImportsMap) {
+		wireNames := []string{}
+		for _, moduleName := range wireImportsMap[modulePath].ModuleNames {
+			wireNames = append(wireNames,
+				"type "+getWireFormatTypeName(moduleName),
+				getWireFormatFromJSONName(moduleName),
+				getWireFormatToJSONName(moduleName),
+			)
+		}
+		allImportLines = append(allImportLines, `import { `+strings.Join(wireNames, ", ")+` } from "`+getWireFormatModulePath(modulePath)+`"`)
+	}
+	return allImportLines
+}
+
+func (w *WireFormatObjectFileWriter) ClearFile(mainObjectName string) error {
+	delete(w.allFileObjects, mainObjectName)
+	return tsfile.ClearTsWireFile(w.TargetDirPath, mainObjectName)
+}
@@ -0,0 +1,7 @@
+package write
+
+// TsFlusher is implemented by writers which accumulate definitions and write their files once all definitions are
+// written, e.g. into a single bundle file.
+type TsFlusher interface {
+	Flush() error
+}
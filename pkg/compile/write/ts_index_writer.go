@@ -0,0 +1,7 @@
+package write
+
+import "github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+
+type TsIndexWriter interface {
+	WriteIndex(indexDirName string, indexDefinition *tsdef.Index) ([]byte, error)
+}
@@ -13,15 +13,17 @@ func WriteAllEntityObjectDefinitions(config MorpheCompileConfig, allEntityObject
 
 	sortedEntityNames := core.MapKeysSorted(allEntityObjectDefs)
 	for _, entityName := range sortedEntityNames {
-		if clearErr := config.EntityWriter.ClearFile(entityName); clearErr != nil {
+		mainObjectName := config.MorpheEntitiesConfig.TypeNaming.GetTypeName(entityName)
+		if clearErr := config.EntityWriter.ClearFile(mainObjectName); clearErr != nil {
 			return nil, clearErr
 		}
 	}
 
 	for _, entityName := range sortedEntityNames {
 		entityObjects := allEntityObjectDefs[entityName]
+		mainObjectName := config.MorpheEntitiesConfig.TypeNaming.GetTypeName(entityName)
 		for _, subEntityObject := range entityObjects {
-			subEntityObject, subEntityObjectContents, writeErr := WriteEntityObjectDefinition(config.WriteObjectHooks, config.EntityWriter, entityName, subEntityObject)
+			subEntityObject, subEntityObjectContents, writeErr := WriteEntityObjectDefinition(config.WriteObjectHooks, config.EntityWriter, mainObjectName, subEntityObject)
 			if writeErr != nil {
 				return nil, writeErr
 			}
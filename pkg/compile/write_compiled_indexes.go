@@ -0,0 +1,124 @@
+package compile
+
+import (
+	"slices"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/go-util/strcase"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/write"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+// CompiledIndexes maps index directory name -> CompiledIndex, the root index uses the empty name
+type CompiledIndexes map[string]CompiledIndex
+
+type CompiledIndex struct {
+	Index         *tsdef.Index
+	IndexContents []byte
+}
+
+// WriteAllIndexDefinitions writes a barrel index per non-empty output directory and a root index
+// re-exporting each directory under its own namespace, since models and entities share type names.
+func WriteAllIndexDefinitions(
+	config MorpheCompileConfig,
+	allEnums CompiledEnums,
+	allModelObjects CompiledModelObjects,
+	allStructureObjects CompiledStructureObjects,
+	allEntityObjects CompiledEntityObjects,
+) (CompiledIndexes, error) {
+	allWrittenIndexes := CompiledIndexes{}
+	rootIndex := tsdef.Index{}
+
+	allIndexedNames := []struct {
+		dirName   string
+		namespace string
+		names     []string
+	}{
+		{config.OutputLayout.GetEnumsDirName(), "Enums", core.MapKeysSorted(allEnums)},
+		{config.OutputLayout.GetModelsDirName(), "Models", getAllTypeNames(config.MorpheModelsConfig.TypeNaming, core.MapKeysSorted(allModelObjects))},
+		{config.OutputLayout.GetStructuresDirName(), "Structures", core.MapKeysSorted(allStructureObjects)},
+		{config.OutputLayout.GetEntitiesDirName(), "Entities", getAllTypeNames(config.MorpheEntitiesConfig.TypeNaming, core.MapKeysSorted(allEntityObjects))},
+	}
+	for _, indexedNames := range allIndexedNames {
+		if len(indexedNames.names) == 0 {
+			continue
+		}
+
+		dirIndex := getIndexForNames(indexedNames.names)
+		dirIndexContents, writeErr := config.IndexWriter.WriteIndex(indexedNames.dirName, &dirIndex)
+		if writeErr != nil {
+			return nil, writeErr
+		}
+		allWrittenIndexes[indexedNames.dirName] = CompiledIndex{
+			Index:         &dirIndex,
+			IndexContents: dirIndexContents,
+		}
+
+		rootIndex.Exports = append(rootIndex.Exports, tsdef.IndexExport{
+			ModulePath: "./" + indexedNames.dirName,
+			Namespace:  indexedNames.namespace,
+		})
+	}
+
+	if len(rootIndex.Exports) == 0 {
+		return allWrittenIndexes, nil
+	}
+
+	rootIndexContents, writeErr := config.IndexWriter.WriteIndex("", &rootIndex)
+	if writeErr != nil {
+		return nil, writeErr
+	}
+	allWrittenIndexes[""] = CompiledIndex{
+		Index:         &rootIndex,
+		IndexContents: rootIndexContents,
+	}
+	return allWrittenIndexes, nil
+}
+
+// hasTsDefinitionFileWriters reports whether all writers write the `.d.ts` definition files the indexes re-export,
+// possibly along with other files through the multi writers.
+func hasTsDefinitionFileWriters(config MorpheCompileConfig) bool {
+	return isTsDefinitionEnumWriter(config.EnumWriter) &&
+		isTsDefinitionObjectWriter(config.ModelWriter) &&
+		isTsDefinitionObjectWriter(config.StructureWriter) &&
+		isTsDefinitionObjectWriter(config.EntityWriter)
+}
+
+func isTsDefinitionEnumWriter(writer write.TsEnumWriter) bool {
+	switch typedWriter := writer.(type) {
+	case *MorpheEnumFileWriter:
+		return true
+	case *MultiEnumWriter:
+		return slices.ContainsFunc(typedWriter.Writers, isTsDefinitionEnumWriter)
+	}
+	return false
+}
+
+func isTsDefinitionObjectWriter(writer write.TsObjectWriter) bool {
+	switch typedWriter := writer.(type) {
+	case *MorpheObjectFileWriter:
+		return true
+	case *MultiObjectWriter:
+		return slices.ContainsFunc(typedWriter.Writers, isTsDefinitionObjectWriter)
+	}
+	return false
+}
+
+func getIndexForNames(sortedNames []string) tsdef.Index {
+	index := tsdef.Index{}
+	for _, name := range sortedNames {
+		index.Exports = append(index.Exports, tsdef.IndexExport{
+			ModulePath: "./" + strcase.ToKebabCaseLower(name),
+		})
+	}
+	return index
+}
+
+func getAllTypeNames(typeNaming cfg.TypeNaming, allNames []string) []string {
+	allTypeNames := []string{}
+	for _, name := range allNames {
+		allTypeNames = append(allTypeNames, typeNaming.GetTypeName(name))
+	}
+	return allTypeNames
+}
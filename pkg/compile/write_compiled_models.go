@@ -13,15 +13,17 @@ func WriteAllModelObjectDefinitions(config MorpheCompileConfig, allModelObjectDe
 
 	sortedModelNames := core.MapKeysSorted(allModelObjectDefs)
 	for _, modelName := range sortedModelNames {
-		if clearErr := config.ModelWriter.ClearFile(modelName); clearErr != nil {
+		mainObjectName := config.MorpheModelsConfig.TypeNaming.GetTypeName(modelName)
+		if clearErr := config.ModelWriter.ClearFile(mainObjectName); clearErr != nil {
 			return nil, clearErr
 		}
 	}
 
 	for _, modelName := range sortedModelNames {
 		modelObjects := allModelObjectDefs[modelName]
+		mainObjectName := config.MorpheModelsConfig.TypeNaming.GetTypeName(modelName)
 		for _, subModelObject := range modelObjects {
-			subModelObject, subModelObjectContents, writeErr := WriteModelObjectDefinition(config.WriteObjectHooks, config.ModelWriter, modelName, subModelObject)
+			subModelObject, subModelObjectContents, writeErr := WriteModelObjectDefinition(config.WriteObjectHooks, config.ModelWriter, mainObjectName, subModelObject)
 			if writeErr != nil {
 				return nil, writeErr
 			}
@@ -0,0 +1,71 @@
+package compile
+
+import (
+	"fmt"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/compile/cfg"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// ZodEnumFileWriter writes enums together with a `z.nativeEnum` schema into `.ts` source files.
+//
+// The schema validates against the enum at runtime, so every style other than the default `export enum` is written
+// as a frozen `as const` object, the only other style defining a value.
+type ZodEnumFileWriter struct {
+	TargetDirPath string
+	// Style is the form enums are declared in, defaults to `export enum`
+	Style cfg.EnumStyle
+}
+
+func (w *ZodEnumFileWriter) WriteEnum(enumName string, enumDefinition *tsdef.Enum) ([]byte, error) {
+	allEnumLines, allLinesErr := w.getAllEnumLines(enumName, enumDefinition)
+	if allLinesErr != nil {
+		return nil, allLinesErr
+	}
+
+	enumFileContents, enumContentsErr := core.LinesToString(allEnumLines)
+	if enumContentsErr != nil {
+		return nil, enumContentsErr
+	}
+
+	return tsfile.WriteTsSourceFile(w.TargetDirPath, enumName, enumFileContents)
+}
+
+func (w *ZodEnumFileWriter) getAllEnumLines(enumName string, enumDefinition *tsdef.Enum) ([]string, error) {
+	enumLines, enumLinesErr := w.getAllEnumDefinitionLines(enumDefinition)
+	if enumLinesErr != nil {
+		return nil, enumLinesErr
+	}
+
+	allEnumLines := []string{}
+	if enumName == enumDefinition.Name {
+		allEnumLines = append(allEnumLines, zodImportLine)
+	}
+	allEnumLines = append(allEnumLines, "")
+	allEnumLines = append(allEnumLines, enumLines...)
+	allEnumLines = append(allEnumLines, "")
+	allEnumLines = append(allEnumLines, fmt.Sprintf(`export const %s = z.nativeEnum(%s)`, getZodSchemaName(enumDefinition.Name), enumDefinition.Name))
+	return allEnumLines, nil
+}
+
+func (w *ZodEnumFileWriter) getAllEnumDefinitionLines(enumDefinition *tsdef.Enum) ([]string, error) {
+	enumWriter := MorpheEnumFileWriter{}
+	if validateErr := w.Style.Validate(); validateErr != nil {
+		return nil, validateErr
+	}
+	if w.Style == cfg.EnumStyleEnum {
+		return enumWriter.getAllEnumLines(enumDefinition.Name, enumDefinition)
+	}
+	constObjectLines, constObjectErr := enumWriter.getAllEnumConstObjectLines(enumDefinition)
+	if constObjectErr != nil {
+		return nil, constObjectErr
+	}
+	allEnumLines := getJsDocLines("", enumDefinition.Docs)
+	return append(allEnumLines, constObjectLines...), nil
+}
+
+func (w *ZodEnumFileWriter) ClearFile(enumName string) error {
+	return tsfile.ClearTsSourceFile(w.TargetDirPath, enumName)
+}
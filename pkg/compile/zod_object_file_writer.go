@@ -0,0 +1,147 @@
+package compile
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kalo-build/go-util/core"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsfile"
+)
+
+// ZodObjectFileWriter writes objects as Zod schemas along with their types into `.ts` source files.
+//
+// Types are inferred from the schemas through `z.infer` where the inferred type is the same as in the `.d.ts` files.
+// Related objects may reference each other, and TypeScript cannot infer the types of circular initializers, so objects
+// referencing other objects (through `z.lazy`) or with readonly fields are declared explicitly instead, and their
+// schemas are annotated as `z.ZodType<Person>`.
+type ZodObjectFileWriter struct {
+	TargetDirPath string
+}
+
+func (w *ZodObjectFileWriter) WriteObject(mainObjectName string, objectDefinition *tsdef.Object) ([]byte, error) {
+	allObjectLines, allLinesErr := w.getAllObjectLines(mainObjectName, objectDefinition)
+	if allLinesErr != nil {
+		return nil, allLinesErr
+	}
+
+	objectFileContents, objectContentsErr := core.LinesToString(allObjectLines)
+	if objectContentsErr != nil {
+		return nil, objectContentsErr
+	}
+
+	return tsfile.WriteTsSourceFile(w.TargetDirPath, mainObjectName, objectFileContents)
+}
+
+func (w *ZodObjectFileWriter) getAllObjectLines(mainObjectName string, objectDefinition *tsdef.Object) ([]string, error) {
+	allObjectLines := []string{}
+
+	if mainObjectName == objectDefinition.Name {
+		allObjectLines = append(allObjectLines, zodImportLine)
+		allObjectLines = append(allObjectLines, w.getAllObjectImportLines(objectDefinition)...)
+	}
+	allObjectLines = append(allObjectLines, "")
+
+	if isZodObjectTypeInferable(objectDefinition) {
+		allObjectLines = append(allObjectLines, getZodObjectSchemaLines(fmt.Sprintf(`export const %s =`, getZodSchemaName(objectDefinition.Name)), objectDefinition, true)...)
+		allObjectLines = append(allObjectLines, "")
+		allObjectLines = append(allObjectLines, getJsDocLines("", objectDefinition.Docs)...)
+		allObjectLines = append(allObjectLines, fmt.Sprintf(`export type %s = z.infer<typeof %s>`, objectDefinition.Name, getZodSchemaName(objectDefinition.Name)))
+		return allObjectLines, nil
+	}
+
+	allObjectLines = append(allObjectLines, getAllObjectTypeLines(objectDefinition)...)
+	allObjectLines = append(allObjectLines, "")
+
+	schemaDeclaration := fmt.Sprintf(`export const %s: z.ZodType<%s> =`, getZodSchemaName(objectDefinition.Name), objectDefinition.Name)
+	allObjectLines = append(allObjectLines, getZodObjectSchemaLines(schemaDeclaration, objectDefinition, false)...)
+	return allObjectLines, nil
+}
+
+// getZodObjectSchemaLines declares the schema of the object, with the field docs if its type is inferred from it.
+func getZodObjectSchemaLines(schemaDeclaration string, objectDefinition *tsdef.Object, withFieldDocs bool) []string {
+	if objectDefinition.Alias != nil {
+		return []string{fmt.Sprintf(`%s %s`, schemaDeclaration, getZodSchemaSyntax(objectDefinition.Alias))}
+	}
+
+	allSchemaLines := []string{schemaDeclaration + " z.object({"}
+	for _, objectField := range objectDefinition.Fields {
+		if withFieldDocs {
+			allSchemaLines = append(allSchemaLines, getJsDocLines("\t", objectField.Docs)...)
+		}
+		fieldName := tsdef.GetTsPropertyNameSyntax(getTsFieldName(objectField.Name), tsdef.TsLiteralQuoteDouble)
+		fieldSchemaSyntax := getZodSchemaSyntax(objectField.Type)
+		allSchemaLines = append(allSchemaLines, fmt.Sprintf("\t%s: %s,", fieldName, fieldSchemaSyntax))
+	}
+	return append(allSchemaLines, "})")
+}
+
+// isZodObjectTypeInferable reports whether `z.infer` yields the declared object type, which holds unless the object
+// references other objects through `z.lazy` (possibly circularly), has readonly fields or is a branded type, whose
+// `z.custom` schema references the type itself.
+func isZodObjectTypeInferable(objectDefinition *tsdef.Object) bool {
+	if _, isBranded := objectDefinition.Alias.(tsdef.TsTypeBranded); isBranded {
+		return false
+	}
+	if objectDefinition.Alias != nil {
+		return !hasZodLazyReference(objectDefinition.Alias)
+	}
+	for _, objectField := range objectDefinition.Fields {
+		if objectField.Readonly || hasZodLazyReference(objectField.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasZodLazyReference(tsType tsdef.TsType) bool {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return hasZodLazyReference(typedType.ValueType)
+	case tsdef.TsTypeArray:
+		return hasZodLazyReference(typedType.ValueType)
+	case tsdef.TsTypeUnion:
+		return slices.ContainsFunc(typedType.Types, hasZodLazyReference)
+	case tsdef.TsTypeObject:
+		return typedType != tsdef.TsTypeDate && !isExternalTsTypeObject(typedType)
+	case tsdef.TsTypeBranded:
+		return hasZodLazyReference(typedType.ValueType)
+	case tsdef.TsTypeNarrowed:
+		return hasZodLazyReference(typedType.ValueType) || hasZodLazyReference(typedType.FieldType)
+	}
+	return false
+}
+
+func (w *ZodObjectFileWriter) getAllObjectImportLines(objectDefinition *tsdef.Object) []string {
+	if len(objectDefinition.Imports) == 0 {
+		return nil
+	}
+
+	filteredImportsMap := map[string]tsdef.ObjectImport{}
+	for _, objectImport := range objectDefinition.Imports {
+		filteredImportsMap[objectImport.ModulePath] = objectImport
+	}
+
+	allImportLines := []string{}
+
+	filteredImports := core.MapKeysSorted(filteredImportsMap)
+	for _, objectImportPath := range filteredImports {
+		objectImport := filteredImportsMap[objectImportPath]
+		if isExternalModulePath(objectImportPath) {
+			allImportLines = append(allImportLines, `import type { `+strings.Join(objectImport.ModuleNames, ", ")+` } from "`+objectImportPath+`"`)
+			continue
+		}
+		allImportNames := []string{}
+		for _, moduleName := range objectImport.ModuleNames {
+			allImportNames = append(allImportNames, "type "+moduleName, getZodSchemaName(moduleName))
+		}
+		allImportLines = append(allImportLines, `import { `+strings.Join(allImportNames, ", ")+` } from "`+objectImportPath+`"`)
+	}
+
+	return allImportLines
+}
+
+func (w *ZodObjectFileWriter) ClearFile(mainObjectName string) error {
+	return tsfile.ClearTsSourceFile(w.TargetDirPath, mainObjectName)
+}
@@ -0,0 +1,65 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kalo-build/plugin-morphe-ts-types/pkg/tsdef"
+)
+
+const zodImportLine = `import { z } from "zod"`
+
+func getZodSchemaName(typeName string) string {
+	return typeName + "Schema"
+}
+
+// getZodSchemaSyntax renders the Zod schema expression which validates values of the given TsType.
+//
+// Object references are wrapped in `z.lazy` so that mutually related models can import each other's schemas before
+// they are initialized, while types imported from packages (see `cfg.TypeMappings`) are accepted as is through
+// `z.custom`. Branded types are checked against their value schema through `z.custom` as well, which keeps the schema
+// output the same brand as in the `.d.ts` files rather than Zod's own `z.BRAND`.
+func getZodSchemaSyntax(tsType tsdef.TsType) string {
+	switch typedType := tsType.(type) {
+	case tsdef.TsTypeOptional:
+		return getZodSchemaSyntax(typedType.ValueType) + ".optional()"
+	case tsdef.TsTypeArray:
+		return fmt.Sprintf("z.array(%s)", getZodSchemaSyntax(typedType.ValueType))
+	case tsdef.TsTypeUnion:
+		if len(typedType.Types) == 1 {
+			return getZodSchemaSyntax(typedType.Types[0])
+		}
+		allUnionSchemas := []string{}
+		for _, unionType := range typedType.Types {
+			allUnionSchemas = append(allUnionSchemas, getZodSchemaSyntax(unionType))
+		}
+		return fmt.Sprintf("z.union([%s])", strings.Join(allUnionSchemas, ", "))
+	case tsdef.TsTypeObject:
+		if typedType == tsdef.TsTypeDate {
+			return "z.date()"
+		}
+		if isExternalTsTypeObject(typedType) {
+			return fmt.Sprintf("z.custom<%s>()", typedType.Name)
+		}
+		return fmt.Sprintf("z.lazy(() => %s)", getZodSchemaName(typedType.Name))
+	case tsdef.TsTypeBranded:
+		return fmt.Sprintf("z.custom<%s>((value) => %s.safeParse(value).success)", typedType.GetSyntax(), getZodSchemaSyntax(typedType.ValueType))
+	case tsdef.TsTypeNarrowed:
+		return fmt.Sprintf("%s.and(z.object({ %s: %s }))", getZodSchemaSyntax(typedType.ValueType), typedType.FieldName, getZodSchemaSyntax(typedType.FieldType))
+	case tsdef.TsTypeStringLiteral:
+		return fmt.Sprintf("z.literal(%s)", typedType.GetSyntax())
+	case tsdef.TsTypePrimitive:
+		return getZodPrimitiveSchemaSyntax(typedType)
+	default:
+		return "z.unknown()"
+	}
+}
+
+func getZodPrimitiveSchemaSyntax(primitiveType tsdef.TsTypePrimitive) string {
+	switch primitiveType.Syntax {
+	case "string", "number", "boolean", "bigint", "null", "undefined", "never", "unknown", "any":
+		return fmt.Sprintf("z.%s()", primitiveType.Syntax)
+	default:
+		return "z.unknown()"
+	}
+}
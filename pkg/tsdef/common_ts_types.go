@@ -10,6 +10,9 @@ var (
 	TsTypeBoolean = TsTypePrimitive{
 		Syntax: "boolean",
 	}
+	TsTypeNull = TsTypePrimitive{
+		Syntax: "null",
+	}
 	TsTypeDate = TsTypeObject{
 		Name: "Date",
 	}
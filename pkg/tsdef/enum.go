@@ -6,6 +6,8 @@ type Enum struct {
 	Name    string
 	Type    TsType
 	Entries []EnumEntry
+	// Docs are the lines of the JSDoc comment rendered above the enum
+	Docs []string
 }
 
 func (s Enum) DeepClone() Enum {
@@ -13,5 +15,6 @@ func (s Enum) DeepClone() Enum {
 		Name:    s.Name,
 		Type:    DeepCloneTsType(s.Type),
 		Entries: clone.DeepCloneSlice(s.Entries),
+		Docs:    clone.Slice(s.Docs),
 	}
 }
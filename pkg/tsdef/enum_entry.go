@@ -1,13 +1,18 @@
 package tsdef
 
+import "github.com/kalo-build/clone"
+
 type EnumEntry struct {
 	Name  string
 	Value any
+	// Docs are the lines of the JSDoc comment rendered above the entry
+	Docs []string
 }
 
 func (f EnumEntry) DeepClone() EnumEntry {
 	return EnumEntry{
 		Name:  f.Name,
 		Value: f.Value,
+		Docs:  clone.Slice(f.Docs),
 	}
 }
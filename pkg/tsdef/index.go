@@ -0,0 +1,14 @@
+package tsdef
+
+import "github.com/kalo-build/clone"
+
+// Index describes a barrel module re-exporting other modules
+type Index struct {
+	Exports []IndexExport
+}
+
+func (i Index) DeepClone() Index {
+	return Index{
+		Exports: clone.DeepCloneSlice(i.Exports),
+	}
+}
@@ -0,0 +1,14 @@
+package tsdef
+
+type IndexExport struct {
+	ModulePath string
+	// Namespace re-exports the module under a single name (export * as Namespace) when set
+	Namespace string
+}
+
+func (e IndexExport) DeepClone() IndexExport {
+	return IndexExport{
+		ModulePath: e.ModulePath,
+		Namespace:  e.Namespace,
+	}
+}
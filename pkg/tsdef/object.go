@@ -6,12 +6,21 @@ type Object struct {
 	Name    string
 	Imports []ObjectImport
 	Fields  []ObjectField
+	// Alias turns the object into a plain type alias (`export type PersonID = ...`), Fields are ignored if set
+	Alias TsType
+	// Docs are the lines of the JSDoc comment rendered above the type
+	Docs []string
 }
 
 func (s Object) DeepClone() Object {
-	return Object{
+	objectClone := Object{
 		Name:    s.Name,
 		Imports: clone.DeepCloneSlice(s.Imports),
 		Fields:  clone.DeepCloneSlice(s.Fields),
+		Docs:    clone.Slice(s.Docs),
 	}
+	if s.Alias != nil {
+		objectClone.Alias = DeepCloneTsType(s.Alias)
+	}
+	return objectClone
 }
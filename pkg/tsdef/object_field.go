@@ -1,13 +1,24 @@
 package tsdef
 
+import "github.com/kalo-build/clone"
+
 type ObjectField struct {
-	Name string
-	Type TsType
+	Name     string
+	Type     TsType
+	Readonly bool
+	// Docs are the lines of the JSDoc comment rendered above the field
+	Docs []string
+	// DateOnly marks a `Date` field holding a calendar date without time of day (Morphe `Date`), written with the
+	// `date` instead of the `date-time` format in JSON Schemas
+	DateOnly bool
 }
 
 func (f ObjectField) DeepClone() ObjectField {
 	return ObjectField{
-		Name: f.Name,
-		Type: DeepCloneTsType(f.Type),
+		Name:     f.Name,
+		Type:     DeepCloneTsType(f.Type),
+		Readonly: f.Readonly,
+		Docs:     clone.Slice(f.Docs),
+		DateOnly: f.DateOnly,
 	}
 }
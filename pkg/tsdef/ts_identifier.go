@@ -0,0 +1,111 @@
+package tsdef
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// allTsReservedWords cannot name declarations such as types and enums, they are still valid property names.
+//
+// Includes the strict mode reserved words and the predefined type names, which cannot name type aliases either.
+var allTsReservedWords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true, "debugger": true,
+	"default": true, "delete": true, "do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true, "import": true, "in": true,
+	"instanceof": true, "new": true, "null": true, "return": true, "super": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+
+	"await": true, "implements": true, "interface": true, "let": true, "package": true, "private": true,
+	"protected": true, "public": true, "static": true, "yield": true,
+
+	"any": true, "bigint": true, "boolean": true, "never": true, "number": true, "object": true, "string": true,
+	"symbol": true, "undefined": true, "unknown": true,
+}
+
+// IsTsIdentifierName reports whether the name can be written as an unquoted property or enum member name.
+func IsTsIdentifierName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for charIdx, char := range name {
+		if charIdx == 0 && !isTsIdentifierStart(char) {
+			return false
+		}
+		if !isTsIdentifierPart(char) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsTsIdentifier reports whether the name can name a declaration, e.g. a type or an enum.
+func IsTsIdentifier(name string) bool {
+	return IsTsIdentifierName(name) && !IsTsReservedWord(name)
+}
+
+func IsTsReservedWord(name string) bool {
+	return allTsReservedWords[name]
+}
+
+// IsTsEnumMemberName reports whether the name can name an enum member if quoted, numeric names such as `'1'` or
+// `'Infinity'` are rejected.
+func IsTsEnumMemberName(name string) bool {
+	return !isTsNumericName(name)
+}
+
+// GetTsPropertyNameSyntax writes the name unquoted if it is an identifier name, e.g. `firstName`, or as a string
+// literal otherwise, e.g. `'first-name'`.
+func GetTsPropertyNameSyntax(name string, quote TsLiteralQuote) string {
+	if IsTsIdentifierName(name) {
+		return name
+	}
+	return GetTsStringLiteralSyntax(name, quote)
+}
+
+// GetTsPropertyAccessSyntax accesses the property with dot notation if possible, e.g. `json.firstName`, or with
+// bracket notation otherwise, e.g. `json['first-name']`.
+func GetTsPropertyAccessSyntax(objectSyntax string, name string, quote TsLiteralQuote) string {
+	if IsTsIdentifierName(name) {
+		return objectSyntax + "." + name
+	}
+	return objectSyntax + "[" + GetTsStringLiteralSyntax(name, quote) + "]"
+}
+
+// GetTsSanitizedIdentifierName drops all characters which cannot be part of an identifier and prefixes the name with
+// `$` if it does not start with an identifier character, e.g. `2FA` becomes `$2FA`.
+func GetTsSanitizedIdentifierName(name string) string {
+	var sanitizedName strings.Builder
+	for _, char := range name {
+		if isTsIdentifierPart(char) {
+			sanitizedName.WriteRune(char)
+		}
+	}
+	if !IsTsIdentifierName(sanitizedName.String()) {
+		return "$" + sanitizedName.String()
+	}
+	return sanitizedName.String()
+}
+
+func isTsIdentifierStart(char rune) bool {
+	return char == '$' || char == '_' || unicode.IsLetter(char) || unicode.Is(unicode.Nl, char)
+}
+
+func isTsIdentifierPart(char rune) bool {
+	return isTsIdentifierStart(char) || unicode.IsDigit(char) || unicode.In(char, unicode.Mn, unicode.Mc, unicode.Pc) ||
+		char == '\u200C' || char == '\u200D'
+}
+
+// isTsNumericName reports whether the name is the string of a number, as TypeScript rejects it as enum member name.
+func isTsNumericName(name string) bool {
+	if name == "NaN" || name == "Infinity" || name == "-Infinity" {
+		return true
+	}
+	number, parseErr := strconv.ParseFloat(name, 64)
+	if parseErr != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return false
+	}
+	numberSyntax, syntaxErr := getTsFloatLiteralSyntax(number, 64)
+	return syntaxErr == nil && numberSyntax == name
+}
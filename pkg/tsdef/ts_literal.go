@@ -0,0 +1,114 @@
+package tsdef
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TsLiteralQuote is the quote character string literals are delimited with.
+type TsLiteralQuote rune
+
+const (
+	TsLiteralQuoteSingle TsLiteralQuote = '\''
+	TsLiteralQuoteDouble TsLiteralQuote = '"'
+)
+
+// GetTsLiteralSyntax formats a string, boolean or number value as a TypeScript literal, e.g. `'German'`, `true` or `42`.
+//
+// Strings are quoted with the given quote character. Numbers are never written in exponent notation, and NaN and
+// Infinity are rejected since they have no literal syntax.
+func GetTsLiteralSyntax(value any, quote TsLiteralQuote) (string, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return GetTsStringLiteralSyntax(typedValue, quote), nil
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	default:
+		return GetTsNumberLiteralSyntax(value)
+	}
+}
+
+// GetTsStringLiteralSyntax quotes and escapes a string as a TypeScript string literal.
+//
+// Printable unicode is kept as is, line terminators and other non-printable characters are escaped, and invalid UTF-8
+// is escaped as the U+FFFD replacement character.
+func GetTsStringLiteralSyntax(value string, quote TsLiteralQuote) string {
+	var literal strings.Builder
+	literal.WriteRune(rune(quote))
+	for byteIdx, char := range value {
+		switch {
+		case char == rune(quote) || char == '\\':
+			literal.WriteRune('\\')
+			literal.WriteRune(char)
+		case char == '\n':
+			literal.WriteString(`\n`)
+		case char == '\r':
+			literal.WriteString(`\r`)
+		case char == '\t':
+			literal.WriteString(`\t`)
+		case char == utf8.RuneError && isInvalidUTF8At(value, byteIdx):
+			literal.WriteString(`\uFFFD`)
+		case char < utf8.RuneSelf && !unicode.IsPrint(char):
+			literal.WriteString(fmt.Sprintf(`\x%02X`, char))
+		case !unicode.IsPrint(char) && char <= 0xFFFF:
+			literal.WriteString(fmt.Sprintf(`\u%04X`, char))
+		case !unicode.IsPrint(char):
+			literal.WriteString(fmt.Sprintf(`\u{%X}`, char))
+		default:
+			literal.WriteRune(char)
+		}
+	}
+	literal.WriteRune(rune(quote))
+	return literal.String()
+}
+
+// GetTsNumberLiteralSyntax formats an integer or float as a TypeScript number literal.
+//
+// Integers keep all their digits and floats are written in their shortest decimal form, so `1e21` becomes
+// `1000000000000000000000` instead of Go's `1e+21`.
+func GetTsNumberLiteralSyntax(value any) (string, error) {
+	switch typedValue := value.(type) {
+	case int:
+		return strconv.FormatInt(int64(typedValue), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(typedValue), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(typedValue), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(typedValue), 10), nil
+	case int64:
+		return strconv.FormatInt(typedValue, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(typedValue), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(typedValue), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(typedValue), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(typedValue), 10), nil
+	case uint64:
+		return strconv.FormatUint(typedValue, 10), nil
+	case float32:
+		return getTsFloatLiteralSyntax(float64(typedValue), 32)
+	case float64:
+		return getTsFloatLiteralSyntax(typedValue, 64)
+	default:
+		return "", ErrUnsupportedLiteralValue(value)
+	}
+}
+
+func getTsFloatLiteralSyntax(value float64, bitSize int) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", ErrNonFiniteNumberLiteral(value)
+	}
+	return strconv.FormatFloat(value, 'f', -1, bitSize), nil
+}
+
+func isInvalidUTF8At(value string, byteIdx int) bool {
+	_, charSize := utf8.DecodeRuneInString(value[byteIdx:])
+	return charSize == 1
+}
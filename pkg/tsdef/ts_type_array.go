@@ -39,9 +39,23 @@ func (t TsTypeArray) GetImports() []ObjectImport {
 }
 
 func (t TsTypeArray) GetSyntax() string {
+	if isCompoundTsType(t.ValueType) {
+		return fmt.Sprintf("(%s)[]", t.ValueType.GetSyntax())
+	}
 	return fmt.Sprintf("%s[]", t.ValueType.GetSyntax())
 }
 
+// isCompoundTsType reports whether the syntax of the type must be parenthesized before applying `[]`.
+func isCompoundTsType(tsType TsType) bool {
+	switch typedType := tsType.(type) {
+	case TsTypeUnion:
+		return len(typedType.Types) > 1
+	case TsTypeBranded, TsTypeNarrowed:
+		return true
+	}
+	return false
+}
+
 func (t TsTypeArray) DeepClone() TsTypeArray {
 	return TsTypeArray{
 		ValueType: DeepCloneTsType(t.ValueType),
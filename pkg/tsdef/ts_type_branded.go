@@ -0,0 +1,50 @@
+package tsdef
+
+// TsTypeBranded is a nominal type, e.g. `number & { readonly __brand: "PersonID" }`.
+type TsTypeBranded struct {
+	ValueType TsType
+	Brand     string
+}
+
+func (t TsTypeBranded) IsPrimitive() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsFunction() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsArray() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsObject() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsInterface() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsPromise() bool {
+	return false
+}
+
+func (t TsTypeBranded) IsOptional() bool {
+	return false
+}
+
+func (t TsTypeBranded) GetSyntax() string {
+	return t.ValueType.GetSyntax() + ` & { readonly __brand: "` + t.Brand + `" }`
+}
+
+func (t TsTypeBranded) DeepClone() TsTypeBranded {
+	return TsTypeBranded{
+		ValueType: DeepCloneTsType(t.ValueType),
+		Brand:     t.Brand,
+	}
+}
+
+func (t TsTypeBranded) GetImports() []ObjectImport {
+	return t.ValueType.GetImports()
+}
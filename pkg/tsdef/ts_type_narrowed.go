@@ -0,0 +1,52 @@
+package tsdef
+
+// TsTypeNarrowed is an object type with one field narrowed to a more specific type, e.g. `Comment & { commentableType: "Person" }`.
+type TsTypeNarrowed struct {
+	ValueType TsType
+	FieldName string
+	FieldType TsType
+}
+
+func (t TsTypeNarrowed) IsPrimitive() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsFunction() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsArray() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsObject() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsInterface() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsPromise() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) IsOptional() bool {
+	return false
+}
+
+func (t TsTypeNarrowed) GetSyntax() string {
+	return t.ValueType.GetSyntax() + " & { " + t.FieldName + ": " + t.FieldType.GetSyntax() + " }"
+}
+
+func (t TsTypeNarrowed) DeepClone() TsTypeNarrowed {
+	return TsTypeNarrowed{
+		ValueType: DeepCloneTsType(t.ValueType),
+		FieldName: t.FieldName,
+		FieldType: DeepCloneTsType(t.FieldType),
+	}
+}
+
+func (t TsTypeNarrowed) GetImports() []ObjectImport {
+	return append(t.ValueType.GetImports(), t.FieldType.GetImports()...)
+}
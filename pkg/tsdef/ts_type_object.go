@@ -52,6 +52,7 @@ func (t TsTypeObject) GetSyntax() string {
 
 func (t TsTypeObject) DeepClone() TsTypeObject {
 	return TsTypeObject{
-		Name: t.Name,
+		ModulePath: t.ModulePath,
+		Name:       t.Name,
 	}
 }
@@ -0,0 +1,48 @@
+package tsdef
+
+// TsTypeStringLiteral is a string literal type, e.g. `"Person"`.
+type TsTypeStringLiteral struct {
+	Value string
+}
+
+func (t TsTypeStringLiteral) IsPrimitive() bool {
+	return true
+}
+
+func (t TsTypeStringLiteral) IsFunction() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) IsArray() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) IsObject() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) IsInterface() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) IsPromise() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) IsOptional() bool {
+	return false
+}
+
+func (t TsTypeStringLiteral) GetImports() []ObjectImport {
+	return nil
+}
+
+func (t TsTypeStringLiteral) GetSyntax() string {
+	return GetTsStringLiteralSyntax(t.Value, TsLiteralQuoteDouble)
+}
+
+func (t TsTypeStringLiteral) DeepClone() TsTypeStringLiteral {
+	return TsTypeStringLiteral{
+		Value: t.Value,
+	}
+}
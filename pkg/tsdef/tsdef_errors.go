@@ -0,0 +1,11 @@
+package tsdef
+
+import "fmt"
+
+func ErrUnsupportedLiteralValue(value any) error {
+	return fmt.Errorf("unsupported typescript literal value '%v' of type %T", value, value)
+}
+
+func ErrNonFiniteNumberLiteral(value float64) error {
+	return fmt.Errorf("typescript number literal must be finite, got '%v'", value)
+}
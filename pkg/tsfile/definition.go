@@ -7,9 +7,29 @@ import (
 	"github.com/kalo-build/go-util/strcase"
 )
 
+const definitionFileSuffix = ".d.ts"
+
 func ClearTsDefinitionFile(dirPath string, definitionName string) error {
+	return clearTsFile(dirPath, definitionName, definitionFileSuffix)
+}
+
+func WriteTsDefinitionFile(dirPath string, definitionName string, definitionFileContents string) ([]byte, error) {
+	return writeTsFile(dirPath, definitionName, definitionFileSuffix, definitionFileContents)
+}
+
+// ReplaceTsDefinitionFile replaces the full contents of the definition file instead of appending to it.
+func ReplaceTsDefinitionFile(dirPath string, definitionName string, definitionFileContents string) ([]byte, error) {
+	definitionFileName := strcase.ToKebabCaseLower(definitionName)
+	definitionFilePath := filepath.Join(dirPath, definitionFileName+definitionFileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(definitionFileContents), os.WriteFile(definitionFilePath, []byte(definitionFileContents), 0644)
+}
+
+func clearTsFile(dirPath string, definitionName string, fileSuffix string) error {
 	definitionFileName := strcase.ToKebabCaseLower(definitionName)
-	definitionFilePath := filepath.Join(dirPath, definitionFileName+".d.ts")
+	definitionFilePath := filepath.Join(dirPath, definitionFileName+fileSuffix)
 	_, err := os.Stat(definitionFilePath)
 	if err == nil {
 		return os.Remove(definitionFilePath)
@@ -20,18 +40,22 @@ func ClearTsDefinitionFile(dirPath string, definitionName string) error {
 	return err
 }
 
-func WriteTsDefinitionFile(dirPath string, definitionName string, definitionFileContents string) ([]byte, error) {
+func writeTsFile(dirPath string, definitionName string, fileSuffix string, definitionFileContents string) ([]byte, error) {
 	definitionFileName := strcase.ToKebabCaseLower(definitionName)
-	definitionFilePath := filepath.Join(dirPath, definitionFileName+".d.ts")
-	if _, readErr := os.ReadDir(dirPath); readErr != nil && os.IsNotExist(readErr) {
-		mkDirErr := os.MkdirAll(dirPath, 0644)
-		if mkDirErr != nil {
-			return nil, mkDirErr
-		}
+	definitionFilePath := filepath.Join(dirPath, definitionFileName+fileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
 	}
 	return []byte(definitionFileContents), appendToFile(definitionFilePath, definitionFileContents)
 }
 
+func ensureDir(dirPath string) error {
+	if _, readErr := os.ReadDir(dirPath); readErr != nil && os.IsNotExist(readErr) {
+		return os.MkdirAll(dirPath, 0644)
+	}
+	return nil
+}
+
 func appendToFile(filePath string, content string) error {
 	fileHandle, handleErr := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
 	if handleErr != nil {
@@ -0,0 +1,24 @@
+package tsfile
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/strcase"
+)
+
+const guardFileSuffix = ".guard.ts"
+
+func ClearTsGuardFile(dirPath string, guardName string) error {
+	return clearTsFile(dirPath, guardName, guardFileSuffix)
+}
+
+// WriteTsGuardFile replaces the full contents of the guard file, since its imports depend on all guards written to it.
+func WriteTsGuardFile(dirPath string, guardName string, guardFileContents string) ([]byte, error) {
+	guardFileName := strcase.ToKebabCaseLower(guardName)
+	guardFilePath := filepath.Join(dirPath, guardFileName+guardFileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(guardFileContents), os.WriteFile(guardFilePath, []byte(guardFileContents), 0644)
+}
@@ -0,0 +1,24 @@
+package tsfile
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/strcase"
+)
+
+const helpersFileSuffix = ".helpers.ts"
+
+func ClearTsHelpersFile(dirPath string, helpersName string) error {
+	return clearTsFile(dirPath, helpersName, helpersFileSuffix)
+}
+
+// WriteTsHelpersFile replaces the full contents of the helpers file, since its imports depend on all helpers written to it.
+func WriteTsHelpersFile(dirPath string, helpersName string, helpersFileContents string) ([]byte, error) {
+	helpersFileName := strcase.ToKebabCaseLower(helpersName)
+	helpersFilePath := filepath.Join(dirPath, helpersFileName+helpersFileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(helpersFileContents), os.WriteFile(helpersFilePath, []byte(helpersFileContents), 0644)
+}
@@ -0,0 +1,16 @@
+package tsfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const indexFileName = "index.ts"
+
+// WriteTsIndexFile replaces the full contents of the index file in the given directory.
+func WriteTsIndexFile(dirPath string, indexFileContents string) ([]byte, error) {
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(indexFileContents), os.WriteFile(filepath.Join(dirPath, indexFileName), []byte(indexFileContents), 0644)
+}
@@ -0,0 +1,24 @@
+package tsfile
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/strcase"
+)
+
+const jsonSchemaFileSuffix = ".schema.json"
+
+func ClearJsonSchemaFile(dirPath string, schemaName string) error {
+	return clearTsFile(dirPath, schemaName, jsonSchemaFileSuffix)
+}
+
+// WriteJsonSchemaFile replaces the full contents of the schema file, since JSON documents cannot be appended to.
+func WriteJsonSchemaFile(dirPath string, schemaName string, schemaFileContents string) ([]byte, error) {
+	schemaFileName := strcase.ToKebabCaseLower(schemaName)
+	schemaFilePath := filepath.Join(dirPath, schemaFileName+jsonSchemaFileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(schemaFileContents), os.WriteFile(schemaFilePath, []byte(schemaFileContents), 0644)
+}
@@ -0,0 +1,11 @@
+package tsfile
+
+const sourceFileSuffix = ".ts"
+
+func ClearTsSourceFile(dirPath string, sourceName string) error {
+	return clearTsFile(dirPath, sourceName, sourceFileSuffix)
+}
+
+func WriteTsSourceFile(dirPath string, sourceName string, sourceFileContents string) ([]byte, error) {
+	return writeTsFile(dirPath, sourceName, sourceFileSuffix, sourceFileContents)
+}
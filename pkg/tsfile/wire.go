@@ -0,0 +1,24 @@
+package tsfile
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kalo-build/go-util/strcase"
+)
+
+const wireFileSuffix = ".wire.ts"
+
+func ClearTsWireFile(dirPath string, wireName string) error {
+	return clearTsFile(dirPath, wireName, wireFileSuffix)
+}
+
+// WriteTsWireFile replaces the full contents of the wire format file, since its imports depend on all types written to it.
+func WriteTsWireFile(dirPath string, wireName string, wireFileContents string) ([]byte, error) {
+	wireFileName := strcase.ToKebabCaseLower(wireName)
+	wireFilePath := filepath.Join(dirPath, wireFileName+wireFileSuffix)
+	if mkDirErr := ensureDir(dirPath); mkDirErr != nil {
+		return nil, mkDirErr
+	}
+	return []byte(wireFileContents), os.WriteFile(wireFilePath, []byte(wireFileContents), 0644)
+}
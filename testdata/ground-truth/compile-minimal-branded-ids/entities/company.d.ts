@@ -0,0 +1,35 @@
+import { CompanyID } from "../models/company"
+import { PersonID } from "../models/person"
+import { Address } from "../structures/address"
+import { Person } from "./person"
+
+/** Morphe entity `Company` from `company.ent` */
+export type Company = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: CompanyID
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: PersonID[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: CompanyID
+}
@@ -0,0 +1,31 @@
+import { Nationality } from "../enums/nationality"
+import { CompanyID } from "../models/company"
+import { PersonID } from "../models/person"
+import { Company } from "./company"
+
+/** Morphe entity `Person` from `person.ent` */
+export type Person = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: PersonID
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: CompanyID
+	/** `ForOne` relation to `Company` */
+	company?: Company
+}
+
+export type PersonIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: PersonID
+}
@@ -0,0 +1,20 @@
+import { CompanyID, Company } from "./company"
+import { PersonID, Person } from "./person"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: CommentID
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: PersonID | CompanyID
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export type CommentID = number & { readonly __brand: "CommentID" }
+
+export type CommentIDPrimary = {
+	id: CommentID
+}
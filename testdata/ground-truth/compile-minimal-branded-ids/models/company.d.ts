@@ -0,0 +1,40 @@
+import { Address } from "../structures/address"
+import { CommentID, Comment } from "./comment"
+import { ContactID, Contact } from "./contact"
+import { PersonID, Person } from "./person"
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: CompanyID
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: CommentID[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: PersonID[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyID = number & { readonly __brand: "CompanyID" }
+
+export type CompanyIDName = {
+	name: string
+}
+
+export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: CompanyID
+}
@@ -0,0 +1,23 @@
+import { PersonID, Person } from "./person"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: ContactInfoID
+	/** `ForOne` relation to `Person` */
+	personID?: PersonID
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export type ContactInfoID = number & { readonly __brand: "ContactInfoID" }
+
+export type ContactInfoIDEmail = {
+	email: string
+}
+
+export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: ContactInfoID
+}
@@ -0,0 +1,12 @@
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = {
+	email: string
+	id: ContactID
+	phone: string
+}
+
+export type ContactID = number & { readonly __brand: "ContactID" }
+
+export type ContactIDPrimary = {
+	id: ContactID
+}
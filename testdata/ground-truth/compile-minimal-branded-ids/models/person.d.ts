@@ -0,0 +1,46 @@
+import { Nationality } from "../enums/nationality"
+import { CommentID, Comment } from "./comment"
+import { CompanyID, Company } from "./company"
+import { ContactID, Contact } from "./contact"
+import { ContactInfoID, ContactInfo } from "./contact-info"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: PersonID
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: CompanyID
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: ContactInfoID
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: CommentID[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export type PersonID = number & { readonly __brand: "PersonID" }
+
+export type PersonIDName = {
+	firstName: string
+	lastName: string
+}
+
+export type PersonIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: PersonID
+}
@@ -0,0 +1,51 @@
+import { z } from "zod"
+import { type CompanyID, CompanyIDSchema } from "../models/company"
+import { type PersonID, PersonIDSchema } from "../models/person"
+import { type Address, AddressSchema } from "../structures/address"
+import { type Person, PersonSchema } from "./person"
+
+/** Morphe entity `Company` from `company.ent` */
+export type Company = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: CompanyID
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: PersonID[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export const CompanySchema: z.ZodType<Company> = z.object({
+	address: z.lazy(() => AddressSchema),
+	id: z.lazy(() => CompanyIDSchema),
+	mainContactEmail: z.string().optional(),
+	name: z.string(),
+	personLastNames: z.array(z.string()),
+	taxID: z.string(),
+	personIDs: z.array(z.lazy(() => PersonIDSchema)).optional(),
+	persons: z.array(z.lazy(() => PersonSchema)).optional(),
+})
+
+export type CompanyIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: CompanyID
+}
+
+export const CompanyIDPrimarySchema: z.ZodType<CompanyIDPrimary> = z.object({
+	id: z.lazy(() => CompanyIDSchema),
+})
@@ -0,0 +1,45 @@
+import { z } from "zod"
+import { type Nationality, NationalitySchema } from "../enums/nationality"
+import { type CompanyID, CompanyIDSchema } from "../models/company"
+import { type PersonID, PersonIDSchema } from "../models/person"
+import { type Company, CompanySchema } from "./company"
+
+/** Morphe entity `Person` from `person.ent` */
+export type Person = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: PersonID
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: CompanyID
+	/** `ForOne` relation to `Company` */
+	company?: Company
+}
+
+export const PersonSchema: z.ZodType<Person> = z.object({
+	email: z.string().optional(),
+	id: z.lazy(() => PersonIDSchema),
+	lastName: z.string(),
+	nationality: z.lazy(() => NationalitySchema),
+	companyID: z.lazy(() => CompanyIDSchema).optional(),
+	company: z.lazy(() => CompanySchema).optional(),
+})
+
+export type PersonIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: PersonID
+}
+
+export const PersonIDPrimarySchema: z.ZodType<PersonIDPrimary> = z.object({
+	id: z.lazy(() => PersonIDSchema),
+})
@@ -0,0 +1,35 @@
+import { z } from "zod"
+import { type CompanyID, CompanyIDSchema, type Company, CompanySchema } from "./company"
+import { type PersonID, PersonIDSchema, type Person, PersonSchema } from "./person"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: CommentID
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: PersonID | CompanyID
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export const CommentSchema: z.ZodType<Comment> = z.object({
+	id: z.lazy(() => CommentIDSchema),
+	text: z.string(),
+	commentableID: z.union([z.lazy(() => PersonIDSchema), z.lazy(() => CompanyIDSchema)]).optional(),
+	commentableType: z.union([z.literal("Person"), z.literal("Company")]).optional(),
+	commentable: z.union([z.lazy(() => PersonSchema), z.lazy(() => CompanySchema)]).optional(),
+})
+
+export type CommentID = number & { readonly __brand: "CommentID" }
+
+export const CommentIDSchema: z.ZodType<CommentID> = z.custom<number & { readonly __brand: "CommentID" }>((value) => z.number().safeParse(value).success)
+
+export type CommentIDPrimary = {
+	id: CommentID
+}
+
+export const CommentIDPrimarySchema: z.ZodType<CommentIDPrimary> = z.object({
+	id: z.lazy(() => CommentIDSchema),
+})
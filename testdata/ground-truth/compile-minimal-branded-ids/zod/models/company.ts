@@ -0,0 +1,64 @@
+import { z } from "zod"
+import { type Address, AddressSchema } from "../structures/address"
+import { type CommentID, CommentIDSchema, type Comment, CommentSchema } from "./comment"
+import { type ContactID, ContactIDSchema, type Contact, ContactSchema } from "./contact"
+import { type PersonID, PersonIDSchema, type Person, PersonSchema } from "./person"
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: CompanyID
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: CommentID[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: PersonID[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export const CompanySchema: z.ZodType<Company> = z.object({
+	address: z.lazy(() => AddressSchema),
+	id: z.lazy(() => CompanyIDSchema),
+	name: z.string(),
+	taxID: z.string(),
+	mailingContactID: z.lazy(() => ContactIDSchema).optional(),
+	mailingContact: z.lazy(() => ContactSchema).optional(),
+	mainContactID: z.lazy(() => ContactIDSchema).optional(),
+	mainContact: z.lazy(() => ContactSchema).optional(),
+	noteIDs: z.array(z.lazy(() => CommentIDSchema)).optional(),
+	notes: z.array(z.lazy(() => CommentSchema).and(z.object({ commentableType: z.literal("Company") }))).optional(),
+	personIDs: z.array(z.lazy(() => PersonIDSchema)).optional(),
+	persons: z.array(z.lazy(() => PersonSchema)).optional(),
+})
+
+export type CompanyID = number & { readonly __brand: "CompanyID" }
+
+export const CompanyIDSchema: z.ZodType<CompanyID> = z.custom<number & { readonly __brand: "CompanyID" }>((value) => z.number().safeParse(value).success)
+
+export const CompanyIDNameSchema = z.object({
+	name: z.string(),
+})
+
+export type CompanyIDName = z.infer<typeof CompanyIDNameSchema>
+
+export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: CompanyID
+}
+
+export const CompanyIDPrimarySchema: z.ZodType<CompanyIDPrimary> = z.object({
+	id: z.lazy(() => CompanyIDSchema),
+})
@@ -0,0 +1,39 @@
+import { z } from "zod"
+import { type PersonID, PersonIDSchema, type Person, PersonSchema } from "./person"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: ContactInfoID
+	/** `ForOne` relation to `Person` */
+	personID?: PersonID
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export const ContactInfoSchema: z.ZodType<ContactInfo> = z.object({
+	email: z.string(),
+	id: z.lazy(() => ContactInfoIDSchema),
+	personID: z.lazy(() => PersonIDSchema).optional(),
+	person: z.lazy(() => PersonSchema).optional(),
+})
+
+export type ContactInfoID = number & { readonly __brand: "ContactInfoID" }
+
+export const ContactInfoIDSchema: z.ZodType<ContactInfoID> = z.custom<number & { readonly __brand: "ContactInfoID" }>((value) => z.number().safeParse(value).success)
+
+export const ContactInfoIDEmailSchema = z.object({
+	email: z.string(),
+})
+
+export type ContactInfoIDEmail = z.infer<typeof ContactInfoIDEmailSchema>
+
+export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: ContactInfoID
+}
+
+export const ContactInfoIDPrimarySchema: z.ZodType<ContactInfoIDPrimary> = z.object({
+	id: z.lazy(() => ContactInfoIDSchema),
+})
@@ -0,0 +1,26 @@
+import { z } from "zod"
+
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = {
+	email: string
+	id: ContactID
+	phone: string
+}
+
+export const ContactSchema: z.ZodType<Contact> = z.object({
+	email: z.string(),
+	id: z.lazy(() => ContactIDSchema),
+	phone: z.string(),
+})
+
+export type ContactID = number & { readonly __brand: "ContactID" }
+
+export const ContactIDSchema: z.ZodType<ContactID> = z.custom<number & { readonly __brand: "ContactID" }>((value) => z.number().safeParse(value).success)
+
+export type ContactIDPrimary = {
+	id: ContactID
+}
+
+export const ContactIDPrimarySchema: z.ZodType<ContactIDPrimary> = z.object({
+	id: z.lazy(() => ContactIDSchema),
+})
@@ -0,0 +1,72 @@
+import { z } from "zod"
+import { type Nationality, NationalitySchema } from "../enums/nationality"
+import { type CommentID, CommentIDSchema, type Comment, CommentSchema } from "./comment"
+import { type CompanyID, CompanyIDSchema, type Company, CompanySchema } from "./company"
+import { type ContactID, ContactIDSchema, type Contact, ContactSchema } from "./contact"
+import { type ContactInfoID, ContactInfoIDSchema, type ContactInfo, ContactInfoSchema } from "./contact-info"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: PersonID
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: CompanyID
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: ContactInfoID
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: CommentID[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: ContactID
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export const PersonSchema: z.ZodType<Person> = z.object({
+	firstName: z.string(),
+	id: z.lazy(() => PersonIDSchema),
+	lastName: z.string(),
+	nationality: z.lazy(() => NationalitySchema),
+	companyID: z.lazy(() => CompanyIDSchema).optional(),
+	company: z.lazy(() => CompanySchema).optional(),
+	contactInfoID: z.lazy(() => ContactInfoIDSchema).optional(),
+	contactInfo: z.lazy(() => ContactInfoSchema).optional(),
+	noteIDs: z.array(z.lazy(() => CommentIDSchema)).optional(),
+	notes: z.array(z.lazy(() => CommentSchema).and(z.object({ commentableType: z.literal("Person") }))).optional(),
+	personalContactID: z.lazy(() => ContactIDSchema).optional(),
+	personalContact: z.lazy(() => ContactSchema).optional(),
+	workContactID: z.lazy(() => ContactIDSchema).optional(),
+	workContact: z.lazy(() => ContactSchema).optional(),
+})
+
+export type PersonID = number & { readonly __brand: "PersonID" }
+
+export const PersonIDSchema: z.ZodType<PersonID> = z.custom<number & { readonly __brand: "PersonID" }>((value) => z.number().safeParse(value).success)
+
+export const PersonIDNameSchema = z.object({
+	firstName: z.string(),
+	lastName: z.string(),
+})
+
+export type PersonIDName = z.infer<typeof PersonIDNameSchema>
+
+export type PersonIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: PersonID
+}
+
+export const PersonIDPrimarySchema: z.ZodType<PersonIDPrimary> = z.object({
+	id: z.lazy(() => PersonIDSchema),
+})
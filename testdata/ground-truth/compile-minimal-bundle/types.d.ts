@@ -0,0 +1,209 @@
+/** Morphe enum `Nationality` from `nationality.enum` */
+export enum Nationality {
+	DE = 'German',
+	FR = 'French',
+	US = 'American'
+}
+
+/** Morphe enum `UniversalNumber` from `universal-number.enum` */
+export enum UniversalNumber {
+	Euler = 2.7182818285,
+	Pi = 3.1415926535
+}
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: number
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export type CommentIDPrimary = {
+	id: number
+}
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: number
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyIDName = {
+	name: string
+}
+
+export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = {
+	email: string
+	id: number
+	phone: string
+}
+
+export type ContactIDPrimary = {
+	id: number
+}
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: number
+	/** `ForOne` relation to `Person` */
+	personID?: number
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export type ContactInfoIDEmail = {
+	email: string
+}
+
+export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: number
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export type PersonIDName = {
+	firstName: string
+	lastName: string
+}
+
+export type PersonIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+/** Morphe structure `Address` from `address.str` */
+export type Address = {
+	city: string
+	houseNr: string
+	street: string
+	zipCode: string
+}
+
+/** Morphe structure `Shipment` from `shipment.str` */
+export type Shipment = {
+	destination: Address
+	origin: Address
+	shippedAt: Date
+	/** Attributes: `list` */
+	stops: Address[]
+	/** Attributes: `list` */
+	trackingCodes: string[]
+}
+
+/** Morphe entity `Company` from `company.ent` */
+export type CompanyEntity = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: PersonEntity[]
+}
+
+export type CompanyEntityIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
+
+/** Morphe entity `Person` from `person.ent` */
+export type PersonEntity = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: CompanyEntity
+}
+
+export type PersonEntityIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
@@ -0,0 +1,45 @@
+import type { Comment, CommentIDPrimary, CommentCommentablePerson, CommentCommentableCompany, CommentCommentable } from "./comment"
+import { isCompany } from "./company.guard"
+import { isPerson } from "./person.guard"
+
+export function isComment(value: unknown): value is Comment {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+		&& typeof record["text"] === "string"
+		&& (record["commentableID"] === undefined || typeof record["commentableID"] === "number")
+		&& (record["commentableType"] === undefined || (record["commentableType"] === "Person" || record["commentableType"] === "Company"))
+		&& (record["commentable"] === undefined || (isPerson(record["commentable"]) || isCompany(record["commentable"])))
+}
+
+export function isCommentIDPrimary(value: unknown): value is CommentIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
+
+export function isCommentCommentablePerson(value: unknown): value is CommentCommentablePerson {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return record["type"] === "Person"
+		&& isPerson(record["value"])
+}
+
+export function isCommentCommentableCompany(value: unknown): value is CommentCommentableCompany {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return record["type"] === "Company"
+		&& isCompany(record["value"])
+}
+
+export function isCommentCommentable(value: unknown): value is CommentCommentable {
+	return (isCommentCommentablePerson(value) || isCommentCommentableCompany(value))
+}
@@ -0,0 +1,17 @@
+import type { Nationality } from "./nationality"
+
+export const NationalityKeys = Object.freeze(['DE', 'FR', 'US'] as const)
+
+export type NationalityKey = (typeof NationalityKeys)[number]
+
+export const NationalityValues = Object.freeze(['German', 'French', 'American']) as readonly Nationality[]
+
+export const NationalityByValue = Object.freeze({
+	'German': 'DE',
+	'French': 'FR',
+	'American': 'US',
+}) as Readonly<Record<Nationality, NationalityKey>>
+
+export function isNationality(value: unknown): value is Nationality {
+	return (NationalityValues as readonly unknown[]).includes(value)
+}
@@ -0,0 +1,16 @@
+import type { UniversalNumber } from "./universal-number"
+
+export const UniversalNumberKeys = Object.freeze(['Euler', 'Pi'] as const)
+
+export type UniversalNumberKey = (typeof UniversalNumberKeys)[number]
+
+export const UniversalNumberValues = Object.freeze([2.7182818285, 3.1415926535]) as readonly UniversalNumber[]
+
+export const UniversalNumberByValue = Object.freeze({
+	2.7182818285: 'Euler',
+	3.1415926535: 'Pi',
+}) as Readonly<Record<UniversalNumber, UniversalNumberKey>>
+
+export function isUniversalNumber(value: unknown): value is UniversalNumber {
+	return (UniversalNumberValues as readonly unknown[]).includes(value)
+}
@@ -0,0 +1,26 @@
+import type { Company, CompanyIDPrimary } from "./company"
+import { isAddress } from "../structures/address.guard"
+import { isPerson } from "./person.guard"
+
+export function isCompany(value: unknown): value is Company {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return isAddress(record["address"])
+		&& typeof record["id"] === "number"
+		&& (record["mainContactEmail"] === undefined || typeof record["mainContactEmail"] === "string")
+		&& typeof record["name"] === "string"
+		&& (Array.isArray(record["personLastNames"]) && record["personLastNames"].every((item) => typeof item === "string"))
+		&& typeof record["taxID"] === "string"
+		&& (record["personIDs"] === undefined || (Array.isArray(record["personIDs"]) && record["personIDs"].every((item) => typeof item === "number")))
+		&& (record["persons"] === undefined || (Array.isArray(record["persons"]) && record["persons"].every((item) => isPerson(item))))
+}
+
+export function isCompanyIDPrimary(value: unknown): value is CompanyIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
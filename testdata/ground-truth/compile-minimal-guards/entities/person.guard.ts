@@ -0,0 +1,24 @@
+import type { Person, PersonIDPrimary } from "./person"
+import { isNationality } from "../enums/nationality.guard"
+import { isCompany } from "./company.guard"
+
+export function isPerson(value: unknown): value is Person {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return (record["email"] === undefined || typeof record["email"] === "string")
+		&& typeof record["id"] === "number"
+		&& typeof record["lastName"] === "string"
+		&& isNationality(record["nationality"])
+		&& (record["companyID"] === undefined || typeof record["companyID"] === "number")
+		&& (record["company"] === undefined || isCompany(record["company"]))
+}
+
+export function isPersonIDPrimary(value: unknown): value is PersonIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
@@ -0,0 +1,7 @@
+import type { Nationality } from "./nationality"
+
+export function isNationality(value: unknown): value is Nationality {
+	return value === 'German'
+		|| value === 'French'
+		|| value === 'American'
+}
@@ -0,0 +1,6 @@
+import type { UniversalNumber } from "./universal-number"
+
+export function isUniversalNumber(value: unknown): value is UniversalNumber {
+	return value === 2.7182818285
+		|| value === 3.1415926535
+}
@@ -0,0 +1,40 @@
+import type { Company, CompanyIDName, CompanyIDPrimary } from "./company"
+import { isAddress } from "../structures/address.guard"
+import { isComment } from "./comment.guard"
+import { isContact } from "./contact.guard"
+import { isPerson } from "./person.guard"
+
+export function isCompany(value: unknown): value is Company {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return isAddress(record["address"])
+		&& typeof record["id"] === "number"
+		&& typeof record["name"] === "string"
+		&& typeof record["taxID"] === "string"
+		&& (record["mailingContactID"] === undefined || typeof record["mailingContactID"] === "number")
+		&& (record["mailingContact"] === undefined || isContact(record["mailingContact"]))
+		&& (record["mainContactID"] === undefined || typeof record["mainContactID"] === "number")
+		&& (record["mainContact"] === undefined || isContact(record["mainContact"]))
+		&& (record["noteIDs"] === undefined || (Array.isArray(record["noteIDs"]) && record["noteIDs"].every((item) => typeof item === "number")))
+		&& (record["notes"] === undefined || (Array.isArray(record["notes"]) && record["notes"].every((item) => (isComment(item) && (item as Record<string, unknown>)["commentableType"] === "Company"))))
+		&& (record["personIDs"] === undefined || (Array.isArray(record["personIDs"]) && record["personIDs"].every((item) => typeof item === "number")))
+		&& (record["persons"] === undefined || (Array.isArray(record["persons"]) && record["persons"].every((item) => isPerson(item))))
+}
+
+export function isCompanyIDName(value: unknown): value is CompanyIDName {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["name"] === "string"
+}
+
+export function isCompanyIDPrimary(value: unknown): value is CompanyIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
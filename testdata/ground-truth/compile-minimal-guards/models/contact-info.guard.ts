@@ -0,0 +1,29 @@
+import type { ContactInfo, ContactInfoIDEmail, ContactInfoIDPrimary } from "./contact-info"
+import { isPerson } from "./person.guard"
+
+export function isContactInfo(value: unknown): value is ContactInfo {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["email"] === "string"
+		&& typeof record["id"] === "number"
+		&& (record["personID"] === undefined || typeof record["personID"] === "number")
+		&& (record["person"] === undefined || isPerson(record["person"]))
+}
+
+export function isContactInfoIDEmail(value: unknown): value is ContactInfoIDEmail {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["email"] === "string"
+}
+
+export function isContactInfoIDPrimary(value: unknown): value is ContactInfoIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
@@ -0,0 +1,19 @@
+import type { Contact, ContactIDPrimary } from "./contact"
+
+export function isContact(value: unknown): value is Contact {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["email"] === "string"
+		&& typeof record["id"] === "number"
+		&& typeof record["phone"] === "string"
+}
+
+export function isContactIDPrimary(value: unknown): value is ContactIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
@@ -0,0 +1,44 @@
+import type { Person, PersonIDName, PersonIDPrimary } from "./person"
+import { isNationality } from "../enums/nationality.guard"
+import { isComment } from "./comment.guard"
+import { isCompany } from "./company.guard"
+import { isContact } from "./contact.guard"
+import { isContactInfo } from "./contact-info.guard"
+
+export function isPerson(value: unknown): value is Person {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["firstName"] === "string"
+		&& typeof record["id"] === "number"
+		&& typeof record["lastName"] === "string"
+		&& isNationality(record["nationality"])
+		&& (record["companyID"] === undefined || typeof record["companyID"] === "number")
+		&& (record["company"] === undefined || isCompany(record["company"]))
+		&& (record["contactInfoID"] === undefined || typeof record["contactInfoID"] === "number")
+		&& (record["contactInfo"] === undefined || isContactInfo(record["contactInfo"]))
+		&& (record["noteIDs"] === undefined || (Array.isArray(record["noteIDs"]) && record["noteIDs"].every((item) => typeof item === "number")))
+		&& (record["notes"] === undefined || (Array.isArray(record["notes"]) && record["notes"].every((item) => (isComment(item) && (item as Record<string, unknown>)["commentableType"] === "Person"))))
+		&& (record["personalContactID"] === undefined || typeof record["personalContactID"] === "number")
+		&& (record["personalContact"] === undefined || isContact(record["personalContact"]))
+		&& (record["workContactID"] === undefined || typeof record["workContactID"] === "number")
+		&& (record["workContact"] === undefined || isContact(record["workContact"]))
+}
+
+export function isPersonIDName(value: unknown): value is PersonIDName {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["firstName"] === "string"
+		&& typeof record["lastName"] === "string"
+}
+
+export function isPersonIDPrimary(value: unknown): value is PersonIDPrimary {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["id"] === "number"
+}
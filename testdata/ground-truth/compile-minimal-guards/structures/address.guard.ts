@@ -0,0 +1,12 @@
+import type { Address } from "./address"
+
+export function isAddress(value: unknown): value is Address {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return typeof record["city"] === "string"
+		&& typeof record["houseNr"] === "string"
+		&& typeof record["street"] === "string"
+		&& typeof record["zipCode"] === "string"
+}
@@ -0,0 +1,14 @@
+import type { Shipment } from "./shipment"
+import { isAddress } from "./address.guard"
+
+export function isShipment(value: unknown): value is Shipment {
+	if (typeof value !== "object" || value === null) {
+		return false
+	}
+	const record = value as Record<string, unknown>
+	return isAddress(record["destination"])
+		&& isAddress(record["origin"])
+		&& record["shippedAt"] instanceof Date
+		&& (Array.isArray(record["stops"]) && record["stops"].every((item) => isAddress(item)))
+		&& (Array.isArray(record["trackingCodes"]) && record["trackingCodes"].every((item) => typeof item === "string"))
+}
@@ -0,0 +1,35 @@
+import { Company } from "./company"
+import { Person } from "./person"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: number
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export type CommentIDPrimary = {
+	id: number
+}
+
+export type CommentCreate = {
+	text?: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+}
+
+export type CommentUpdate = {
+	id: number
+	text?: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+}
@@ -0,0 +1,68 @@
+import { Address } from "../structures/address"
+import { Comment } from "./comment"
+import { Contact } from "./contact"
+import { Person } from "./person"
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: number
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyIDName = {
+	name: string
+}
+
+export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+export type CompanyCreate = {
+	address?: Address
+	name?: string
+	taxID?: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+}
+
+export type CompanyUpdate = {
+	address?: Address
+	/** Attributes: `mandatory` */
+	id: number
+	name?: string
+	taxID?: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+}
@@ -0,0 +1,35 @@
+import { Person } from "./person"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: number
+	/** `ForOne` relation to `Person` */
+	personID?: number
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export type ContactInfoIDEmail = {
+	email: string
+}
+
+export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+export type ContactInfoCreate = {
+	email?: string
+	/** `ForOne` relation to `Person` */
+	personID?: number
+}
+
+export type ContactInfoUpdate = {
+	email?: string
+	/** Attributes: `mandatory` */
+	id: number
+	/** `ForOne` relation to `Person` */
+	personID?: number
+}
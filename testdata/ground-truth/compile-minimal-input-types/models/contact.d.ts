@@ -0,0 +1,21 @@
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = {
+	email: string
+	id: number
+	phone: string
+}
+
+export type ContactIDPrimary = {
+	id: number
+}
+
+export type ContactCreate = {
+	email?: string
+	phone?: string
+}
+
+export type ContactUpdate = {
+	email?: string
+	id: number
+	phone?: string
+}
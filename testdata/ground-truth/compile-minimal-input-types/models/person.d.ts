@@ -0,0 +1,78 @@
+import { Nationality } from "../enums/nationality"
+import { Comment } from "./comment"
+import { Company } from "./company"
+import { Contact } from "./contact"
+import { ContactInfo } from "./contact-info"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: number
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export type PersonIDName = {
+	firstName: string
+	lastName: string
+}
+
+export type PersonIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
+
+export type PersonCreate = {
+	firstName?: string
+	lastName?: string
+	nationality?: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+}
+
+export type PersonUpdate = {
+	firstName?: string
+	/** Attributes: `mandatory` */
+	id: number
+	lastName?: string
+	nationality?: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+}
@@ -0,0 +1,34 @@
+import { Address } from "../structures/address"
+import { Person } from "./person"
+import { Id } from "@acme/ids"
+
+/** Morphe entity `Company` from `company.ent` */
+export type Company = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: Id
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: Id[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: Id
+}
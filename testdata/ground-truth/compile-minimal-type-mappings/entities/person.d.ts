@@ -0,0 +1,30 @@
+import { Nationality } from "../enums/nationality"
+import { Company } from "./company"
+import { Id } from "@acme/ids"
+
+/** Morphe entity `Person` from `person.ent` */
+export type Person = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: Id
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: Id
+	/** `ForOne` relation to `Company` */
+	company?: Company
+}
+
+export type PersonIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: Id
+}
@@ -0,0 +1,19 @@
+import { Company } from "./company"
+import { Person } from "./person"
+import { Id } from "@acme/ids"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: Id
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: Id
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export type CommentIDPrimary = {
+	id: Id
+}
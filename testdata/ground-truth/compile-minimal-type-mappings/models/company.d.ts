@@ -0,0 +1,39 @@
+import { Address } from "../structures/address"
+import { Comment } from "./comment"
+import { Contact } from "./contact"
+import { Person } from "./person"
+import { Id } from "@acme/ids"
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: Id
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: Id[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: Id[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export type CompanyIDName = {
+	name: string
+}
+
+export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: Id
+}
@@ -0,0 +1,22 @@
+import { Person } from "./person"
+import { Id } from "@acme/ids"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: Id
+	/** `ForOne` relation to `Person` */
+	personID?: Id
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export type ContactInfoIDEmail = {
+	email: string
+}
+
+export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: Id
+}
@@ -0,0 +1,12 @@
+import { Id } from "@acme/ids"
+
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = {
+	email: string
+	id: Id
+	phone: string
+}
+
+export type ContactIDPrimary = {
+	id: Id
+}
@@ -0,0 +1,45 @@
+import { Nationality } from "../enums/nationality"
+import { Comment } from "./comment"
+import { Company } from "./company"
+import { Contact } from "./contact"
+import { ContactInfo } from "./contact-info"
+import { Id } from "@acme/ids"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: Id
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: Id
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: Id
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: Id[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export type PersonIDName = {
+	firstName: string
+	lastName: string
+}
+
+export type PersonIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: Id
+}
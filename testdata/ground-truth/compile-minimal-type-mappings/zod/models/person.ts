@@ -0,0 +1,67 @@
+import { z } from "zod"
+import { type Nationality, NationalitySchema } from "../enums/nationality"
+import { type Comment, CommentSchema } from "./comment"
+import { type Company, CompanySchema } from "./company"
+import { type Contact, ContactSchema } from "./contact"
+import { type ContactInfo, ContactInfoSchema } from "./contact-info"
+import type { Id } from "@acme/ids"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: Id
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: Id
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: Id
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: Id[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: Id
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export const PersonSchema: z.ZodType<Person> = z.object({
+	firstName: z.string(),
+	id: z.custom<Id>(),
+	lastName: z.string(),
+	nationality: z.lazy(() => NationalitySchema),
+	companyID: z.custom<Id>().optional(),
+	company: z.lazy(() => CompanySchema).optional(),
+	contactInfoID: z.custom<Id>().optional(),
+	contactInfo: z.lazy(() => ContactInfoSchema).optional(),
+	noteIDs: z.array(z.custom<Id>()).optional(),
+	notes: z.array(z.lazy(() => CommentSchema).and(z.object({ commentableType: z.literal("Person") }))).optional(),
+	personalContactID: z.custom<Id>().optional(),
+	personalContact: z.lazy(() => ContactSchema).optional(),
+	workContactID: z.custom<Id>().optional(),
+	workContact: z.lazy(() => ContactSchema).optional(),
+})
+
+export const PersonIDNameSchema = z.object({
+	firstName: z.string(),
+	lastName: z.string(),
+})
+
+export type PersonIDName = z.infer<typeof PersonIDNameSchema>
+
+export const PersonIDPrimarySchema = z.object({
+	/** Attributes: `mandatory` */
+	id: z.custom<Id>(),
+})
+
+export type PersonIDPrimary = z.infer<typeof PersonIDPrimarySchema>
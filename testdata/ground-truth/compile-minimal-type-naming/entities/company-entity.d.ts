@@ -0,0 +1,33 @@
+import { Address } from "../structures/address"
+import { PersonEntity } from "./person-entity"
+
+/** Morphe entity `Company` from `company.ent` */
+export type CompanyEntity = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: PersonEntity[]
+}
+
+export type CompanyEntityIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
@@ -0,0 +1,2 @@
+export * from "./company-entity"
+export * from "./person-entity"
@@ -0,0 +1,29 @@
+import { Nationality } from "../enums/nationality"
+import { CompanyEntity } from "./company-entity"
+
+/** Morphe entity `Person` from `person.ent` */
+export type PersonEntity = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: CompanyEntity
+}
+
+export type PersonEntityIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
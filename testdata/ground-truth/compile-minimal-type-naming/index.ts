@@ -0,0 +1,4 @@
+export * as Enums from "./enums"
+export * as Models from "./models"
+export * as Structures from "./structures"
+export * as Entities from "./entities"
@@ -0,0 +1,18 @@
+import { CompanyModel } from "./company-model"
+import { PersonModel } from "./person-model"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type CommentModel = {
+	id: number
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: PersonModel | CompanyModel
+}
+
+export type CommentModelIDPrimary = {
+	id: number
+}
@@ -0,0 +1,38 @@
+import { Address } from "../structures/address"
+import { CommentModel } from "./comment-model"
+import { ContactModel } from "./contact-model"
+import { PersonModel } from "./person-model"
+
+/** Morphe model `Company` from `company.mod` */
+export type CompanyModel = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: number
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: ContactModel
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContact?: ContactModel
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (CommentModel & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: PersonModel[]
+}
+
+export type CompanyModelIDName = {
+	name: string
+}
+
+export type CompanyModelIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
@@ -0,0 +1,21 @@
+import { PersonModel } from "./person-model"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfoModel = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: number
+	/** `ForOne` relation to `Person` */
+	personID?: number
+	/** `ForOne` relation to `Person` */
+	person?: PersonModel
+}
+
+export type ContactInfoModelIDEmail = {
+	email: string
+}
+
+export type ContactInfoModelIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
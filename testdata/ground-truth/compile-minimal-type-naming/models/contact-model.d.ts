@@ -0,0 +1,10 @@
+/** Morphe model `Contact` from `contact.mod` */
+export type ContactModel = {
+	email: string
+	id: number
+	phone: string
+}
+
+export type ContactModelIDPrimary = {
+	id: number
+}
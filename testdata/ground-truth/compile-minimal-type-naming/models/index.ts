@@ -0,0 +1,5 @@
+export * from "./comment-model"
+export * from "./company-model"
+export * from "./contact-model"
+export * from "./contact-info-model"
+export * from "./person-model"
@@ -0,0 +1,44 @@
+import { Nationality } from "../enums/nationality"
+import { CommentModel } from "./comment-model"
+import { CompanyModel } from "./company-model"
+import { ContactInfoModel } from "./contact-info-model"
+import { ContactModel } from "./contact-model"
+
+/** Morphe model `Person` from `person.mod` */
+export type PersonModel = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: number
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: CompanyModel
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfoModel
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (CommentModel & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	personalContact?: ContactModel
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContact?: ContactModel
+}
+
+export type PersonModelIDName = {
+	firstName: string
+	lastName: string
+}
+
+export type PersonModelIDPrimary = {
+	/** Attributes: `mandatory` */
+	id: number
+}
@@ -0,0 +1,56 @@
+import type { Company, CompanyIDPrimary } from "./company"
+import { type AddressJSON, fromAddressJSON, toAddressJSON } from "../structures/address.wire"
+import { type PersonJSON, fromPersonJSON, toPersonJSON } from "./person.wire"
+
+export type CompanyJSON = {
+	address: AddressJSON
+	readonly id: number
+	mainContactEmail?: string
+	name: string
+	personLastNames: string[]
+	taxID: string
+	personIDs?: number[]
+	persons?: PersonJSON[]
+}
+
+export function fromCompanyJSON(json: CompanyJSON): Company {
+	return {
+		address: fromAddressJSON(json.address),
+		id: json.id,
+		mainContactEmail: json.mainContactEmail,
+		name: json.name,
+		personLastNames: json.personLastNames,
+		taxID: json.taxID,
+		personIDs: json.personIDs,
+		persons: (json.persons === undefined ? undefined : json.persons.map((item) => fromPersonJSON(item))),
+	}
+}
+
+export function toCompanyJSON(value: Company): CompanyJSON {
+	return {
+		address: toAddressJSON(value.address),
+		id: value.id,
+		mainContactEmail: value.mainContactEmail,
+		name: value.name,
+		personLastNames: value.personLastNames,
+		taxID: value.taxID,
+		personIDs: value.personIDs,
+		persons: (value.persons === undefined ? undefined : value.persons.map((item) => toPersonJSON(item))),
+	}
+}
+
+export type CompanyIDPrimaryJSON = {
+	readonly id: number
+}
+
+export function fromCompanyIDPrimaryJSON(json: CompanyIDPrimaryJSON): CompanyIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toCompanyIDPrimaryJSON(value: CompanyIDPrimary): CompanyIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
@@ -0,0 +1,50 @@
+import type { Person, PersonIDPrimary } from "./person"
+import { type NationalityJSON, fromNationalityJSON, toNationalityJSON } from "../enums/nationality.wire"
+import { type CompanyJSON, fromCompanyJSON, toCompanyJSON } from "./company.wire"
+
+export type PersonJSON = {
+	email?: string
+	readonly id: number
+	lastName: string
+	nationality: NationalityJSON
+	companyID?: number
+	company?: CompanyJSON
+}
+
+export function fromPersonJSON(json: PersonJSON): Person {
+	return {
+		email: json.email,
+		id: json.id,
+		lastName: json.lastName,
+		nationality: fromNationalityJSON(json.nationality),
+		companyID: json.companyID,
+		company: (json.company === undefined ? undefined : fromCompanyJSON(json.company)),
+	}
+}
+
+export function toPersonJSON(value: Person): PersonJSON {
+	return {
+		email: value.email,
+		id: value.id,
+		lastName: value.lastName,
+		nationality: toNationalityJSON(value.nationality),
+		companyID: value.companyID,
+		company: (value.company === undefined ? undefined : toCompanyJSON(value.company)),
+	}
+}
+
+export type PersonIDPrimaryJSON = {
+	readonly id: number
+}
+
+export function fromPersonIDPrimaryJSON(json: PersonIDPrimaryJSON): PersonIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toPersonIDPrimaryJSON(value: PersonIDPrimary): PersonIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
@@ -0,0 +1,11 @@
+import type { Nationality } from "./nationality"
+
+export type NationalityJSON = Nationality
+
+export function fromNationalityJSON(json: NationalityJSON): Nationality {
+	return json
+}
+
+export function toNationalityJSON(value: Nationality): NationalityJSON {
+	return value
+}
@@ -0,0 +1,11 @@
+import type { UniversalNumber } from "./universal-number"
+
+export type UniversalNumberJSON = UniversalNumber
+
+export function fromUniversalNumberJSON(json: UniversalNumberJSON): UniversalNumber {
+	return json
+}
+
+export function toUniversalNumberJSON(value: UniversalNumber): UniversalNumberJSON {
+	return value
+}
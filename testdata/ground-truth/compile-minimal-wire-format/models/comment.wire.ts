@@ -0,0 +1,49 @@
+import type { Comment, CommentIDPrimary } from "./comment"
+import type { Company } from "./company"
+import type { Person } from "./person"
+import { type CompanyJSON, fromCompanyJSON, toCompanyJSON } from "./company.wire"
+import { type PersonJSON, fromPersonJSON, toPersonJSON } from "./person.wire"
+
+export type CommentJSON = {
+	id: number
+	text: string
+	commentableID?: number
+	commentableType?: "Person" | "Company"
+	commentable?: PersonJSON | CompanyJSON
+}
+
+export function fromCommentJSON(json: CommentJSON): Comment {
+	return {
+		id: json.id,
+		text: json.text,
+		commentableID: json.commentableID,
+		commentableType: json.commentableType,
+		commentable: (json.commentable === undefined ? undefined : (json.commentableType === "Person" ? fromPersonJSON(json.commentable as PersonJSON) : fromCompanyJSON(json.commentable as CompanyJSON))),
+	}
+}
+
+export function toCommentJSON(value: Comment): CommentJSON {
+	return {
+		id: value.id,
+		text: value.text,
+		commentableID: value.commentableID,
+		commentableType: value.commentableType,
+		commentable: (value.commentable === undefined ? undefined : (value.commentableType === "Person" ? toPersonJSON(value.commentable as Person) : toCompanyJSON(value.commentable as Company))),
+	}
+}
+
+export type CommentIDPrimaryJSON = {
+	id: number
+}
+
+export function fromCommentIDPrimaryJSON(json: CommentIDPrimaryJSON): CommentIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toCommentIDPrimaryJSON(value: CommentIDPrimary): CommentIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
@@ -0,0 +1,87 @@
+import type { Company, CompanyIDName, CompanyIDPrimary } from "./company"
+import type { Comment } from "./comment"
+import { type AddressJSON, fromAddressJSON, toAddressJSON } from "../structures/address.wire"
+import { type CommentJSON, fromCommentJSON, toCommentJSON } from "./comment.wire"
+import { type ContactJSON, fromContactJSON, toContactJSON } from "./contact.wire"
+import { type PersonJSON, fromPersonJSON, toPersonJSON } from "./person.wire"
+
+export type CompanyJSON = {
+	address: AddressJSON
+	id: number
+	name: string
+	taxID: string
+	mailingContactID?: number
+	mailingContact?: ContactJSON
+	mainContactID?: number
+	mainContact?: ContactJSON
+	noteIDs?: number[]
+	notes?: (CommentJSON & { commentableType: "Company" })[]
+	personIDs?: number[]
+	persons?: PersonJSON[]
+}
+
+export function fromCompanyJSON(json: CompanyJSON): Company {
+	return {
+		address: fromAddressJSON(json.address),
+		id: json.id,
+		name: json.name,
+		taxID: json.taxID,
+		mailingContactID: json.mailingContactID,
+		mailingContact: (json.mailingContact === undefined ? undefined : fromContactJSON(json.mailingContact)),
+		mainContactID: json.mainContactID,
+		mainContact: (json.mainContact === undefined ? undefined : fromContactJSON(json.mainContact)),
+		noteIDs: json.noteIDs,
+		notes: (json.notes === undefined ? undefined : json.notes.map((item) => (fromCommentJSON(item) as Comment & { commentableType: "Company" }))),
+		personIDs: json.personIDs,
+		persons: (json.persons === undefined ? undefined : json.persons.map((item) => fromPersonJSON(item))),
+	}
+}
+
+export function toCompanyJSON(value: Company): CompanyJSON {
+	return {
+		address: toAddressJSON(value.address),
+		id: value.id,
+		name: value.name,
+		taxID: value.taxID,
+		mailingContactID: value.mailingContactID,
+		mailingContact: (value.mailingContact === undefined ? undefined : toContactJSON(value.mailingContact)),
+		mainContactID: value.mainContactID,
+		mainContact: (value.mainContact === undefined ? undefined : toContactJSON(value.mainContact)),
+		noteIDs: value.noteIDs,
+		notes: (value.notes === undefined ? undefined : value.notes.map((item) => (toCommentJSON(item) as CommentJSON & { commentableType: "Company" }))),
+		personIDs: value.personIDs,
+		persons: (value.persons === undefined ? undefined : value.persons.map((item) => toPersonJSON(item))),
+	}
+}
+
+export type CompanyIDNameJSON = {
+	name: string
+}
+
+export function fromCompanyIDNameJSON(json: CompanyIDNameJSON): CompanyIDName {
+	return {
+		name: json.name,
+	}
+}
+
+export function toCompanyIDNameJSON(value: CompanyIDName): CompanyIDNameJSON {
+	return {
+		name: value.name,
+	}
+}
+
+export type CompanyIDPrimaryJSON = {
+	id: number
+}
+
+export function fromCompanyIDPrimaryJSON(json: CompanyIDPrimaryJSON): CompanyIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toCompanyIDPrimaryJSON(value: CompanyIDPrimary): CompanyIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
@@ -0,0 +1,59 @@
+import type { ContactInfo, ContactInfoIDEmail, ContactInfoIDPrimary } from "./contact-info"
+import { type PersonJSON, fromPersonJSON, toPersonJSON } from "./person.wire"
+
+export type ContactInfoJSON = {
+	email: string
+	id: number
+	personID?: number
+	person?: PersonJSON
+}
+
+export function fromContactInfoJSON(json: ContactInfoJSON): ContactInfo {
+	return {
+		email: json.email,
+		id: json.id,
+		personID: json.personID,
+		person: (json.person === undefined ? undefined : fromPersonJSON(json.person)),
+	}
+}
+
+export function toContactInfoJSON(value: ContactInfo): ContactInfoJSON {
+	return {
+		email: value.email,
+		id: value.id,
+		personID: value.personID,
+		person: (value.person === undefined ? undefined : toPersonJSON(value.person)),
+	}
+}
+
+export type ContactInfoIDEmailJSON = {
+	email: string
+}
+
+export function fromContactInfoIDEmailJSON(json: ContactInfoIDEmailJSON): ContactInfoIDEmail {
+	return {
+		email: json.email,
+	}
+}
+
+export function toContactInfoIDEmailJSON(value: ContactInfoIDEmail): ContactInfoIDEmailJSON {
+	return {
+		email: value.email,
+	}
+}
+
+export type ContactInfoIDPrimaryJSON = {
+	id: number
+}
+
+export function fromContactInfoIDPrimaryJSON(json: ContactInfoIDPrimaryJSON): ContactInfoIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toContactInfoIDPrimaryJSON(value: ContactInfoIDPrimary): ContactInfoIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
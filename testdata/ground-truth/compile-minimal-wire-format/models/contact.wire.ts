@@ -0,0 +1,39 @@
+import type { Contact, ContactIDPrimary } from "./contact"
+
+export type ContactJSON = {
+	email: string
+	id: number
+	phone: string
+}
+
+export function fromContactJSON(json: ContactJSON): Contact {
+	return {
+		email: json.email,
+		id: json.id,
+		phone: json.phone,
+	}
+}
+
+export function toContactJSON(value: Contact): ContactJSON {
+	return {
+		email: value.email,
+		id: value.id,
+		phone: value.phone,
+	}
+}
+
+export type ContactIDPrimaryJSON = {
+	id: number
+}
+
+export function fromContactIDPrimaryJSON(json: ContactIDPrimaryJSON): ContactIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toContactIDPrimaryJSON(value: ContactIDPrimary): ContactIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
@@ -0,0 +1,97 @@
+import type { Person, PersonIDName, PersonIDPrimary } from "./person"
+import type { Comment } from "./comment"
+import { type NationalityJSON, fromNationalityJSON, toNationalityJSON } from "../enums/nationality.wire"
+import { type CommentJSON, fromCommentJSON, toCommentJSON } from "./comment.wire"
+import { type CompanyJSON, fromCompanyJSON, toCompanyJSON } from "./company.wire"
+import { type ContactJSON, fromContactJSON, toContactJSON } from "./contact.wire"
+import { type ContactInfoJSON, fromContactInfoJSON, toContactInfoJSON } from "./contact-info.wire"
+
+export type PersonJSON = {
+	firstName: string
+	id: number
+	lastName: string
+	nationality: NationalityJSON
+	companyID?: number
+	company?: CompanyJSON
+	contactInfoID?: number
+	contactInfo?: ContactInfoJSON
+	noteIDs?: number[]
+	notes?: (CommentJSON & { commentableType: "Person" })[]
+	personalContactID?: number
+	personalContact?: ContactJSON
+	workContactID?: number
+	workContact?: ContactJSON
+}
+
+export function fromPersonJSON(json: PersonJSON): Person {
+	return {
+		firstName: json.firstName,
+		id: json.id,
+		lastName: json.lastName,
+		nationality: fromNationalityJSON(json.nationality),
+		companyID: json.companyID,
+		company: (json.company === undefined ? undefined : fromCompanyJSON(json.company)),
+		contactInfoID: json.contactInfoID,
+		contactInfo: (json.contactInfo === undefined ? undefined : fromContactInfoJSON(json.contactInfo)),
+		noteIDs: json.noteIDs,
+		notes: (json.notes === undefined ? undefined : json.notes.map((item) => (fromCommentJSON(item) as Comment & { commentableType: "Person" }))),
+		personalContactID: json.personalContactID,
+		personalContact: (json.personalContact === undefined ? undefined : fromContactJSON(json.personalContact)),
+		workContactID: json.workContactID,
+		workContact: (json.workContact === undefined ? undefined : fromContactJSON(json.workContact)),
+	}
+}
+
+export function toPersonJSON(value: Person): PersonJSON {
+	return {
+		firstName: value.firstName,
+		id: value.id,
+		lastName: value.lastName,
+		nationality: toNationalityJSON(value.nationality),
+		companyID: value.companyID,
+		company: (value.company === undefined ? undefined : toCompanyJSON(value.company)),
+		contactInfoID: value.contactInfoID,
+		contactInfo: (value.contactInfo === undefined ? undefined : toContactInfoJSON(value.contactInfo)),
+		noteIDs: value.noteIDs,
+		notes: (value.notes === undefined ? undefined : value.notes.map((item) => (toCommentJSON(item) as CommentJSON & { commentableType: "Person" }))),
+		personalContactID: value.personalContactID,
+		personalContact: (value.personalContact === undefined ? undefined : toContactJSON(value.personalContact)),
+		workContactID: value.workContactID,
+		workContact: (value.workContact === undefined ? undefined : toContactJSON(value.workContact)),
+	}
+}
+
+export type PersonIDNameJSON = {
+	firstName: string
+	lastName: string
+}
+
+export function fromPersonIDNameJSON(json: PersonIDNameJSON): PersonIDName {
+	return {
+		firstName: json.firstName,
+		lastName: json.lastName,
+	}
+}
+
+export function toPersonIDNameJSON(value: PersonIDName): PersonIDNameJSON {
+	return {
+		firstName: value.firstName,
+		lastName: value.lastName,
+	}
+}
+
+export type PersonIDPrimaryJSON = {
+	id: number
+}
+
+export function fromPersonIDPrimaryJSON(json: PersonIDPrimaryJSON): PersonIDPrimary {
+	return {
+		id: json.id,
+	}
+}
+
+export function toPersonIDPrimaryJSON(value: PersonIDPrimary): PersonIDPrimaryJSON {
+	return {
+		id: value.id,
+	}
+}
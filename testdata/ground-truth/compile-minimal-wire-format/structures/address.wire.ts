@@ -0,0 +1,26 @@
+import type { Address } from "./address"
+
+export type AddressJSON = {
+	city: string
+	houseNr: string
+	street: string
+	zipCode: string
+}
+
+export function fromAddressJSON(json: AddressJSON): Address {
+	return {
+		city: json.city,
+		houseNr: json.houseNr,
+		street: json.street,
+		zipCode: json.zipCode,
+	}
+}
+
+export function toAddressJSON(value: Address): AddressJSON {
+	return {
+		city: value.city,
+		houseNr: value.houseNr,
+		street: value.street,
+		zipCode: value.zipCode,
+	}
+}
@@ -0,0 +1,30 @@
+import type { Shipment } from "./shipment"
+import { type AddressJSON, fromAddressJSON, toAddressJSON } from "./address.wire"
+
+export type ShipmentJSON = {
+	destination: AddressJSON
+	origin: AddressJSON
+	shippedAt: string
+	stops: AddressJSON[]
+	trackingCodes: string[]
+}
+
+export function fromShipmentJSON(json: ShipmentJSON): Shipment {
+	return {
+		destination: fromAddressJSON(json.destination),
+		origin: fromAddressJSON(json.origin),
+		shippedAt: new Date(json.shippedAt),
+		stops: json.stops.map((item) => fromAddressJSON(item)),
+		trackingCodes: json.trackingCodes,
+	}
+}
+
+export function toShipmentJSON(value: Shipment): ShipmentJSON {
+	return {
+		destination: toAddressJSON(value.destination),
+		origin: toAddressJSON(value.origin),
+		shippedAt: value.shippedAt.toISOString(),
+		stops: value.stops.map((item) => toAddressJSON(item)),
+		trackingCodes: value.trackingCodes,
+	}
+}
@@ -0,0 +1,49 @@
+import { z } from "zod"
+import { type Address, AddressSchema } from "../structures/address"
+import { type Person, PersonSchema } from "./person"
+
+/** Morphe entity `Company` from `company.ent` */
+export type Company = {
+	/** From `Company.Address` */
+	address: Address
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Company.MainContact.Email` */
+	mainContactEmail?: string
+	/** From `Company.Name` */
+	name: string
+	/** From `Company.Person.LastName` */
+	personLastNames: string[]
+	/** From `Company.TaxID` */
+	taxID: string
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export const CompanySchema: z.ZodType<Company> = z.object({
+	address: z.lazy(() => AddressSchema),
+	id: z.number(),
+	mainContactEmail: z.string().optional(),
+	name: z.string(),
+	personLastNames: z.array(z.string()),
+	taxID: z.string(),
+	personIDs: z.array(z.number()).optional(),
+	persons: z.array(z.lazy(() => PersonSchema)).optional(),
+})
+
+export type CompanyIDPrimary = {
+	/**
+	 * From `Company.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
+
+export const CompanyIDPrimarySchema: z.ZodType<CompanyIDPrimary> = z.object({
+	id: z.number(),
+})
@@ -0,0 +1,43 @@
+import { z } from "zod"
+import { type Nationality, NationalitySchema } from "../enums/nationality"
+import { type Company, CompanySchema } from "./company"
+
+/** Morphe entity `Person` from `person.ent` */
+export type Person = {
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Person.LastName` */
+	lastName: string
+	/** From `Person.Nationality` */
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: Company
+}
+
+export const PersonSchema: z.ZodType<Person> = z.object({
+	email: z.string().optional(),
+	id: z.number(),
+	lastName: z.string(),
+	nationality: z.lazy(() => NationalitySchema),
+	companyID: z.number().optional(),
+	company: z.lazy(() => CompanySchema).optional(),
+})
+
+export type PersonIDPrimary = {
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+}
+
+export const PersonIDPrimarySchema: z.ZodType<PersonIDPrimary> = z.object({
+	id: z.number(),
+})
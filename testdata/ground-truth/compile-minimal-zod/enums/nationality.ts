@@ -0,0 +1,10 @@
+import { z } from "zod"
+
+/** Morphe enum `Nationality` from `nationality.enum` */
+export enum Nationality {
+	DE = 'German',
+	FR = 'French',
+	US = 'American'
+}
+
+export const NationalitySchema = z.nativeEnum(Nationality)
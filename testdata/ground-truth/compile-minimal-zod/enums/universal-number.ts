@@ -0,0 +1,9 @@
+import { z } from "zod"
+
+/** Morphe enum `UniversalNumber` from `universal-number.enum` */
+export enum UniversalNumber {
+	Euler = 2.7182818285,
+	Pi = 3.1415926535
+}
+
+export const UniversalNumberSchema = z.nativeEnum(UniversalNumber)
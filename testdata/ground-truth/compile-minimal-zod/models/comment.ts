@@ -0,0 +1,29 @@
+import { z } from "zod"
+import { type Company, CompanySchema } from "./company"
+import { type Person, PersonSchema } from "./person"
+
+/** Morphe model `Comment` from `comment.mod` */
+export type Comment = {
+	id: number
+	text: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentable?: Person | Company
+}
+
+export const CommentSchema: z.ZodType<Comment> = z.object({
+	id: z.number(),
+	text: z.string(),
+	commentableID: z.number().optional(),
+	commentableType: z.union([z.literal("Person"), z.literal("Company")]).optional(),
+	commentable: z.union([z.lazy(() => PersonSchema), z.lazy(() => CompanySchema)]).optional(),
+})
+
+export const CommentIDPrimarySchema = z.object({
+	id: z.number(),
+})
+
+export type CommentIDPrimary = z.infer<typeof CommentIDPrimarySchema>
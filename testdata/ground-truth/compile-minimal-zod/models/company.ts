@@ -0,0 +1,58 @@
+import { z } from "zod"
+import { type Address, AddressSchema } from "../structures/address"
+import { type Comment, CommentSchema } from "./comment"
+import { type Contact, ContactSchema } from "./contact"
+import { type Person, PersonSchema } from "./person"
+
+/** Morphe model `Company` from `company.mod` */
+export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
+	id: number
+	name: string
+	taxID: string
+	/** `ForOne` relation to `Contact` */
+	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	mainContactID?: number
+	/** `ForOne` relation to `Contact` */
+	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
+	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
+	persons?: Person[]
+}
+
+export const CompanySchema: z.ZodType<Company> = z.object({
+	address: z.lazy(() => AddressSchema),
+	id: z.number(),
+	name: z.string(),
+	taxID: z.string(),
+	mailingContactID: z.number().optional(),
+	mailingContact: z.lazy(() => ContactSchema).optional(),
+	mainContactID: z.number().optional(),
+	mainContact: z.lazy(() => ContactSchema).optional(),
+	noteIDs: z.array(z.number()).optional(),
+	notes: z.array(z.lazy(() => CommentSchema).and(z.object({ commentableType: z.literal("Company") }))).optional(),
+	personIDs: z.array(z.number()).optional(),
+	persons: z.array(z.lazy(() => PersonSchema)).optional(),
+})
+
+export const CompanyIDNameSchema = z.object({
+	name: z.string(),
+})
+
+export type CompanyIDName = z.infer<typeof CompanyIDNameSchema>
+
+export const CompanyIDPrimarySchema = z.object({
+	/** Attributes: `mandatory` */
+	id: z.number(),
+})
+
+export type CompanyIDPrimary = z.infer<typeof CompanyIDPrimarySchema>
@@ -0,0 +1,33 @@
+import { z } from "zod"
+import { type Person, PersonSchema } from "./person"
+
+/** Morphe model `ContactInfo` from `contact-info.mod` */
+export type ContactInfo = {
+	email: string
+	/** Attributes: `mandatory` */
+	id: number
+	/** `ForOne` relation to `Person` */
+	personID?: number
+	/** `ForOne` relation to `Person` */
+	person?: Person
+}
+
+export const ContactInfoSchema: z.ZodType<ContactInfo> = z.object({
+	email: z.string(),
+	id: z.number(),
+	personID: z.number().optional(),
+	person: z.lazy(() => PersonSchema).optional(),
+})
+
+export const ContactInfoIDEmailSchema = z.object({
+	email: z.string(),
+})
+
+export type ContactInfoIDEmail = z.infer<typeof ContactInfoIDEmailSchema>
+
+export const ContactInfoIDPrimarySchema = z.object({
+	/** Attributes: `mandatory` */
+	id: z.number(),
+})
+
+export type ContactInfoIDPrimary = z.infer<typeof ContactInfoIDPrimarySchema>
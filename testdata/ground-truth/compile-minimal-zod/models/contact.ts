@@ -0,0 +1,16 @@
+import { z } from "zod"
+
+export const ContactSchema = z.object({
+	email: z.string(),
+	id: z.number(),
+	phone: z.string(),
+})
+
+/** Morphe model `Contact` from `contact.mod` */
+export type Contact = z.infer<typeof ContactSchema>
+
+export const ContactIDPrimarySchema = z.object({
+	id: z.number(),
+})
+
+export type ContactIDPrimary = z.infer<typeof ContactIDPrimarySchema>
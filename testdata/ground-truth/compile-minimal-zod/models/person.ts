@@ -0,0 +1,66 @@
+import { z } from "zod"
+import { type Nationality, NationalitySchema } from "../enums/nationality"
+import { type Comment, CommentSchema } from "./comment"
+import { type Company, CompanySchema } from "./company"
+import { type Contact, ContactSchema } from "./contact"
+import { type ContactInfo, ContactInfoSchema } from "./contact-info"
+
+/** Morphe model `Person` from `person.mod` */
+export type Person = {
+	firstName: string
+	/** Attributes: `mandatory` */
+	id: number
+	lastName: string
+	nationality: Nationality
+	/** `ForOne` relation to `Company` */
+	companyID?: number
+	/** `ForOne` relation to `Company` */
+	company?: Company
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfoID?: number
+	/** `HasOne` relation to `ContactInfo` */
+	contactInfo?: ContactInfo
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	noteIDs?: number[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Person" })[]
+	/** `ForOne` relation to `Contact` */
+	personalContactID?: number
+	/** `ForOne` relation to `Contact` */
+	personalContact?: Contact
+	/** `ForOne` relation to `Contact` */
+	workContactID?: number
+	/** `ForOne` relation to `Contact` */
+	workContact?: Contact
+}
+
+export const PersonSchema: z.ZodType<Person> = z.object({
+	firstName: z.string(),
+	id: z.number(),
+	lastName: z.string(),
+	nationality: z.lazy(() => NationalitySchema),
+	companyID: z.number().optional(),
+	company: z.lazy(() => CompanySchema).optional(),
+	contactInfoID: z.number().optional(),
+	contactInfo: z.lazy(() => ContactInfoSchema).optional(),
+	noteIDs: z.array(z.number()).optional(),
+	notes: z.array(z.lazy(() => CommentSchema).and(z.object({ commentableType: z.literal("Person") }))).optional(),
+	personalContactID: z.number().optional(),
+	personalContact: z.lazy(() => ContactSchema).optional(),
+	workContactID: z.number().optional(),
+	workContact: z.lazy(() => ContactSchema).optional(),
+})
+
+export const PersonIDNameSchema = z.object({
+	firstName: z.string(),
+	lastName: z.string(),
+})
+
+export type PersonIDName = z.infer<typeof PersonIDNameSchema>
+
+export const PersonIDPrimarySchema = z.object({
+	/** Attributes: `mandatory` */
+	id: z.number(),
+})
+
+export type PersonIDPrimary = z.infer<typeof PersonIDPrimarySchema>
@@ -0,0 +1,11 @@
+import { z } from "zod"
+
+export const AddressSchema = z.object({
+	city: z.string(),
+	houseNr: z.string(),
+	street: z.string(),
+	zipCode: z.string(),
+})
+
+/** Morphe structure `Address` from `address.str` */
+export type Address = z.infer<typeof AddressSchema>
@@ -0,0 +1,21 @@
+import { z } from "zod"
+import { type Address, AddressSchema } from "./address"
+
+/** Morphe structure `Shipment` from `shipment.str` */
+export type Shipment = {
+	destination: Address
+	origin: Address
+	shippedAt: Date
+	/** Attributes: `list` */
+	stops: Address[]
+	/** Attributes: `list` */
+	trackingCodes: string[]
+}
+
+export const ShipmentSchema: z.ZodType<Shipment> = z.object({
+	destination: z.lazy(() => AddressSchema),
+	origin: z.lazy(() => AddressSchema),
+	shippedAt: z.date(),
+	stops: z.array(z.lazy(() => AddressSchema)),
+	trackingCodes: z.array(z.string()),
+})
@@ -1,15 +1,29 @@
 import { Nationality } from "../enums/nationality"
 import { Company } from "./company"
 
+/** Morphe entity `Person` from `person.ent` */
 export type Person = {
-	email: string
-	id: number
+	/** From `Person.ContactInfo.Email` */
+	email?: string
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
+	/** From `Person.LastName` */
 	lastName: string
+	/** From `Person.Nationality` */
 	nationality: Nationality
+	/** `ForOne` relation to `Company` */
 	companyID?: number
+	/** `ForOne` relation to `Company` */
 	company?: Company
 }
 
 export type PersonIDPrimary = {
-	id: number
+	/**
+	 * From `Person.ID`
+	 * Attributes: `immutable`, `mandatory`
+	 */
+	readonly id: number
 }
@@ -0,0 +1,2 @@
+export * from "./nationality"
+export * from "./universal-number"
@@ -1,3 +1,4 @@
+/** Morphe enum `Nationality` from `nationality.enum` */
 export enum Nationality {
 	DE = 'German',
 	FR = 'French',
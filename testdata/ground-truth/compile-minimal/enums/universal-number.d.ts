@@ -1,3 +1,4 @@
+/** Morphe enum `UniversalNumber` from `universal-number.enum` */
 export enum UniversalNumber {
 	Euler = 2.7182818285,
 	Pi = 3.1415926535
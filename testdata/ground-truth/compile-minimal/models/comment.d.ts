@@ -1,11 +1,15 @@
 import { Company } from "./company"
 import { Person } from "./person"
 
+/** Morphe model `Comment` from `comment.mod` */
 export type Comment = {
 	id: number
 	text: string
-	commentableID?: string
-	commentableType?: string
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableID?: number
+	/** `ForOnePoly` relation for `Person`, `Company` */
+	commentableType?: "Person" | "Company"
+	/** `ForOnePoly` relation for `Person`, `Company` */
 	commentable?: Person | Company
 }
 
@@ -1,18 +1,30 @@
+import { Address } from "../structures/address"
 import { Comment } from "./comment"
 import { Contact } from "./contact"
 import { Person } from "./person"
 
+/** Morphe model `Company` from `company.mod` */
 export type Company = {
+	address: Address
+	/** Attributes: `mandatory` */
 	id: number
 	name: string
 	taxID: string
+	/** `ForOne` relation to `Contact` */
 	mailingContactID?: number
+	/** `ForOne` relation to `Contact` */
 	mailingContact?: Contact
+	/** `ForOne` relation to `Contact` */
 	mainContactID?: number
+	/** `ForOne` relation to `Contact` */
 	mainContact?: Contact
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
 	noteIDs?: number[]
-	notes?: Comment[]
+	/** `HasManyPoly` relation to `Comment` through `Commentable` */
+	notes?: (Comment & { commentableType: "Company" })[]
+	/** `HasMany` relation to `Person` */
 	personIDs?: number[]
+	/** `HasMany` relation to `Person` */
 	persons?: Person[]
 }
 
@@ -21,5 +33,6 @@ export type CompanyIDName = {
 }
 
 export type CompanyIDPrimary = {
+	/** Attributes: `mandatory` */
 	id: number
 }
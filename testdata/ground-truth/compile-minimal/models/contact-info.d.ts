@@ -1,9 +1,13 @@
 import { Person } from "./person"
 
+/** Morphe model `ContactInfo` from `contact-info.mod` */
 export type ContactInfo = {
 	email: string
+	/** Attributes: `mandatory` */
 	id: number
+	/** `ForOne` relation to `Person` */
 	personID?: number
+	/** `ForOne` relation to `Person` */
 	person?: Person
 }
 
@@ -12,5 +16,6 @@ export type ContactInfoIDEmail = {
 }
 
 export type ContactInfoIDPrimary = {
+	/** Attributes: `mandatory` */
 	id: number
 }
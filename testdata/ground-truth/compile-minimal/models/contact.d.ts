@@ -1,3 +1,4 @@
+/** Morphe model `Contact` from `contact.mod` */
 export type Contact = {
 	email: string
 	id: number
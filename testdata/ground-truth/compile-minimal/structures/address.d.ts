@@ -1,3 +1,4 @@
+/** Morphe structure `Address` from `address.str` */
 export type Address = {
 	city: string
 	houseNr: string
@@ -0,0 +1,12 @@
+import { Address } from "./address"
+
+/** Morphe structure `Shipment` from `shipment.str` */
+export type Shipment = {
+	destination: Address
+	origin: Address
+	shippedAt: Date
+	/** Attributes: `list` */
+	stops: Address[]
+	/** Attributes: `list` */
+	trackingCodes: string[]
+}
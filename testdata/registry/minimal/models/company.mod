@@ -8,6 +8,8 @@ fields:
     type: String
   TaxID:
     type: String
+  Address:
+    type: Address
 identifiers:
   primary: ID
   name: Name